@@ -48,7 +48,14 @@ func main() {
 	}
 
 	// get authorization code
-	// GET https://www.linkedin.com/oauth/v2/authorization?response_type=code&client_id={client_id}&redirect_uri={redirect_uri_with_https}&state={foobar}&scope={scopes}
+	// redirect the member to the authorization URL, LinkedIn redirects back
+	// to the redirect URI with the authorization code and the state
+	authURL, _, err := GlobalApp.AuthCodeURL([]string{"openid", "profile", "email"})
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	fmt.Println("Authorization URL:", authURL)
 
 	// redeem authorization code for access and refresh tokens
 	code := strings.TrimSpace(os.Getenv("LINKEDIN_AUTH_CODE"))
@@ -100,7 +107,7 @@ func main() {
 	fmt.Println("AuthType:", refreshTokenData.AuthType)
 
 	// refresh tokens
-	newTokens, err := session.RefreshToken(token.RefreshToken)
+	newTokens, err := GlobalApp.RefreshToken(token.RefreshToken)
 	if err != nil {
 		fmt.Println(err)
 		return
@@ -46,74 +46,18 @@ func main() {
 	// set Authorization header
 	session.UseAuthorizationHeader()
 
-	// list of all organizations
-	var elements []linkedin.ElementOrganization
-
-	// get the first page of organizations
-	count := "100"
-	response, data, err := session.Get("/organizationAcls?q=roleAssignee&count=" + count)
-	if err != nil {
-		fmt.Println(err)
-		return
-	}
+	// iterate over all organizations
+	it := linkedin.Iterate[linkedin.ElementOrganization](session.Context(), session, "/organizationAcls", linkedin.Params{
+		"q": "roleAssignee",
+	}, &linkedin.IterateOptions{Count: 100})
 
-	if response.StatusCode != http.StatusOK {
-		fmt.Println("LinkedIn: unexpected status code", response.StatusCode)
-		return
-	}
-
-	// parse the response body
-	var organization linkedin.Organization
-	err = json.Unmarshal(data, &organization)
+	// list of all organizations
+	elements, err := it.All()
 	if err != nil {
 		fmt.Println(err)
 		return
 	}
 
-	// append the elements
-	elements = append(elements, organization.Elements...)
-
-	// loop through the pages
-	for {
-		// get the next page URL
-		hasNext, next := organization.Paging.GetNext()
-		if !hasNext {
-			fmt.Println("No more pages, breaking the loop...")
-			break
-		}
-
-		// get the next page
-		fmt.Println("Next page:", next)
-		response, data, err := session.Get(next)
-		if err != nil {
-			fmt.Println("LinkedIn: error fetching the next page")
-			fmt.Println(err)
-			fmt.Println("Breaking the loop...")
-			break
-		}
-
-		if response.StatusCode != http.StatusOK {
-			fmt.Println("LinkedIn: unexpected status code", response.StatusCode)
-			fmt.Println("Breaking the loop...")
-			break
-		}
-
-		// reset the organization
-		organization = linkedin.Organization{}
-
-		// parse the response body
-		err = json.Unmarshal(data, &organization)
-		if err != nil {
-			fmt.Println("LinkedIn: error parsing response")
-			fmt.Println(err)
-			fmt.Println("Breaking the loop...")
-			break
-		}
-
-		// append the elements
-		elements = append(elements, organization.Elements...)
-	}
-
 	fmt.Println("Total organizations:", len(elements))
 
 	// print the organizations
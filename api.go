@@ -36,6 +36,8 @@ const (
 	Authorization         Header = "Authorization"
 	UserAgent             Header = "user-agent"
 	CreatedEntityID       Header = "X-RestLi-Id"
+	RequestID             Header = "x-li-uuid"
+	ETag                  Header = "ETag"
 )
 
 // ContentDataType - HTTP content data type
@@ -43,8 +45,9 @@ type ContentDataType string
 
 // HTTP content data type
 const (
-	URLEncoded ContentDataType = "application/x-www-form-urlencoded"
-	JSON       ContentDataType = "application/json"
+	URLEncoded  ContentDataType = "application/x-www-form-urlencoded"
+	JSON        ContentDataType = "application/json"
+	OctetStream ContentDataType = "application/octet-stream"
 )
 
 // RestLiMethod - LinkedIn Rest.Li method type
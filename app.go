@@ -1,6 +1,7 @@
 package linkedin
 
 import (
+	"context"
 	"fmt"
 	"strings"
 )
@@ -13,7 +14,11 @@ type App struct {
 	ClientID     string
 	ClientSecret string
 	RedirectURI  string
-	session      *Session
+	OAuthBaseURL string      // set to override OauthBaseURL, e.g. for a mock server or an egress proxy
+	UserInfoURL  string      // set to override UserInfoURL
+	HTTPClient   HTTPClient  // HTTP client for OAuth and API requests, http.DefaultClient if nil
+	StateKey     []byte      // key to sign OAuth states, ClientSecret is used if empty
+	RateLimiter  RateLimiter // shared by all sessions of the app, optional
 }
 
 // New creates a new LinkedIn application and sets clientID and clientSecret.
@@ -21,7 +26,6 @@ func New(clientID, clientSecret string) *App {
 	return &App{
 		ClientID:     clientID,
 		ClientSecret: clientSecret,
-		session:      defaultSession,
 	}
 }
 
@@ -35,7 +39,7 @@ func (app *App) ParseCode(code string) (Token, error) {
 		return Token{}, err
 	}
 
-	token, err := app.session.sendAuthRequest("/accessToken", Params{
+	token, err := app.authSession().sendAuthRequest("/accessToken", Params{
 		"grant_type":    "authorization_code",
 		"client_id":     app.ClientID,
 		"client_secret": app.ClientSecret,
@@ -46,17 +50,52 @@ func (app *App) ParseCode(code string) (Token, error) {
 	return token, err
 }
 
+// ParseCodeWithVerifier redeems authorization code for access and refresh tokens
+// using the PKCE code verifier which was used to build the authorization URL.
+//
+// ClientSecret is not required, so public clients such as native apps
+// do not need to embed it. See NewPKCE and WithPKCE.
+func (app *App) ParseCodeWithVerifier(code, codeVerifier string) (Token, error) {
+	code = strings.TrimSpace(code)
+	if code == "" {
+		err := fmt.Errorf("linkedIn: authorization code is empty")
+		return Token{}, err
+	}
+	codeVerifier = strings.TrimSpace(codeVerifier)
+	if codeVerifier == "" {
+		err := fmt.Errorf("linkedIn: code verifier is empty")
+		return Token{}, err
+	}
+
+	token, err := app.authSession().sendAuthRequest("/accessToken", Params{
+		"grant_type":    "authorization_code",
+		"client_id":     app.ClientID,
+		"client_secret": app.ClientSecret,
+		"redirect_uri":  app.RedirectURI,
+		"code":          code,
+		"code_verifier": codeVerifier,
+	})
+
+	return token, err
+}
+
 // RefreshToken redeems refresh token for new access and refresh tokens.
 //
 // See: https://learn.microsoft.com/en-us/linkedin/shared/authentication/programmatic-refresh-tokens?toc=%2Flinkedin%2Fmarketing%2Ftoc.json&bc=%2Flinkedin%2Fbreadcrumb%2Ftoc.json&view=li-lms-2024-04
 func (app *App) RefreshToken(refreshToken string) (Token, error) {
+	return app.RefreshTokenWithContext(context.Background(), refreshToken)
+}
+
+// RefreshTokenWithContext is RefreshToken with a context which controls
+// the timeout and cancellation of the request.
+func (app *App) RefreshTokenWithContext(ctx context.Context, refreshToken string) (Token, error) {
 	refreshToken = strings.TrimSpace(refreshToken)
 	if refreshToken == "" {
 		err := fmt.Errorf("linkedIn: refresh token is empty")
 		return Token{}, err
 	}
 
-	token, err := app.session.sendAuthRequest("/accessToken", Params{
+	token, err := app.authSession().WithContext(ctx).sendAuthRequest("/accessToken", Params{
 		"grant_type":    "refresh_token",
 		"client_id":     app.ClientID,
 		"client_secret": app.ClientSecret,
@@ -66,13 +105,59 @@ func (app *App) RefreshToken(refreshToken string) (Token, error) {
 	return token, err
 }
 
+// ClientCredentials requests an application access token with the
+// 2-legged OAuth client credentials flow.
+//
+// See: https://learn.microsoft.com/en-us/linkedin/shared/authentication/client-credentials-flow
+func (app *App) ClientCredentials() (Token, error) {
+	token, err := app.authSession().sendAuthRequest("/accessToken", Params{
+		"grant_type":    "client_credentials",
+		"client_id":     app.ClientID,
+		"client_secret": app.ClientSecret,
+	})
+
+	return token, err
+}
+
+// ClientCredentialsSession requests an application access token with the
+// client credentials flow and creates a new LinkedIn session for
+// application-level API calls. The token is passed in the Authorization header.
+func (app *App) ClientCredentialsSession() (*Session, Token, error) {
+	token, err := app.ClientCredentials()
+	if err != nil {
+		return nil, Token{}, err
+	}
+
+	session := app.Session(token.AccessToken)
+	session.UseAuthorizationHeader()
+	return session, token, nil
+}
+
+// authSession returns a session without access token for OAuth requests.
+func (app *App) authSession() *Session {
+	return &Session{
+		HTTPClient: app.HTTPClient,
+		app:        app,
+	}
+}
+
+// oauthBaseURL returns the base URL of the OAuth endpoints.
+func (app *App) oauthBaseURL() string {
+	if app.OAuthBaseURL != "" {
+		return strings.TrimSuffix(app.OAuthBaseURL, "/")
+	}
+	return OauthBaseURL
+}
+
 // Session creates a new LinkedIn session based on the app configuration.
 func (app *App) Session(accessToken string) *Session {
 	return &Session{
+		HTTPClient:      app.HTTPClient,
 		BaseURL:         VersionedBaseURL,
 		accessToken:     accessToken,
 		app:             app,
 		LinkedInVersion: "202510",
+		rateLimiter:     app.RateLimiter,
 	}
 }
 
@@ -0,0 +1,97 @@
+package linkedin
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+)
+
+// newTokenEndpointApp returns an app whose fake token endpoint records the form
+// of the last request in form, unless it is nil, and responds with respond
+func newTokenEndpointApp(t *testing.T, form *url.Values, respond func(form url.Values) (status int, body string)) *App {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != "/oauth/v2/accessToken" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL)
+		}
+		_ = r.ParseForm()
+		if form != nil {
+			*form = r.PostForm
+		}
+		status, body := respond(r.PostForm)
+		w.WriteHeader(status)
+		fmt.Fprint(w, body)
+	}))
+	t.Cleanup(server.Close)
+
+	app := New("client", "secret")
+	app.RedirectURI = "https://example.com/callback"
+	app.OAuthBaseURL = server.URL + "/oauth/v2"
+	return app
+}
+
+// TestClientCredentialsSession tests the 2-legged client credentials flow
+func TestClientCredentialsSession(t *testing.T) {
+	var form url.Values
+	app := newTokenEndpointApp(t, &form, func(url.Values) (int, string) {
+		return http.StatusOK, `{"access_token":"app-token","expires_in":1799}`
+	})
+
+	session, token, err := app.ClientCredentialsSession()
+	if err != nil {
+		t.Fatalf("ClientCredentialsSession() error = %v", err)
+	}
+	if token.AccessToken != "app-token" || session.AccessToken() != "app-token" {
+		t.Errorf("ClientCredentialsSession() token = %q, session token = %q; want app-token", token.AccessToken, session.AccessToken())
+	}
+	if !session.useAuthorizationHeader {
+		t.Errorf("ClientCredentialsSession() session does not use the Authorization header")
+	}
+
+	if form.Get("grant_type") != "client_credentials" || form.Get("client_id") != "client" || form.Get("client_secret") != "secret" {
+		t.Errorf("unexpected form %v", form)
+	}
+	for _, key := range []string{"redirect_uri", "code", "code_verifier", "refresh_token"} {
+		if _, ok := form[key]; ok {
+			t.Errorf("form contains %s: %v", key, form)
+		}
+	}
+}
+
+// TestParseCodeWithVerifier tests redeeming an authorization code as a public client
+func TestParseCodeWithVerifier(t *testing.T) {
+	var form url.Values
+	app := newTokenEndpointApp(t, &form, func(url.Values) (int, string) {
+		return http.StatusOK, `{"access_token":"app-token","expires_in":1799}`
+	})
+	app.ClientSecret = ""
+
+	pkce, err := NewPKCE()
+	if err != nil {
+		t.Fatalf("NewPKCE() error = %v", err)
+	}
+	authURL, state, err := app.AuthCodeURL([]string{"openid"}, WithPKCE(pkce))
+	if err != nil || state == "" {
+		t.Fatalf("AuthCodeURL() without client secret = %q, %v; want unsigned state", state, err)
+	}
+	if u, _ := url.Parse(authURL); u.Query().Get("state") != state {
+		t.Errorf("AuthCodeURL() state = %q; want %q", u.Query().Get("state"), state)
+	}
+
+	token, err := app.ParseCodeWithVerifier("code", pkce.Verifier)
+	if err != nil || token.AccessToken != "app-token" {
+		t.Fatalf("ParseCodeWithVerifier() = %q, %v; want app-token", token.AccessToken, err)
+	}
+	if form.Get("grant_type") != "authorization_code" || form.Get("code") != "code" ||
+		form.Get("code_verifier") != pkce.Verifier || form.Get("redirect_uri") != app.RedirectURI {
+		t.Errorf("unexpected form %v", form)
+	}
+	if _, ok := form["client_secret"]; ok {
+		t.Errorf("form contains client_secret: %v", form)
+	}
+
+	if _, err := app.ParseCode("code"); err == nil {
+		t.Errorf("ParseCode() without client secret error = nil; want error")
+	}
+}
@@ -0,0 +1,262 @@
+package linkedin
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// DefaultStateTTL - validity of a state generated by App.AuthCodeURL
+const DefaultStateTTL = 10 * time.Minute
+
+// Errors returned by App.VerifyState
+var (
+	ErrInvalidState = errors.New("linkedIn: invalid state")
+	ErrStateExpired = errors.New("linkedIn: state expired")
+)
+
+// AuthCodeOption customizes the authorization URL built by App.AuthCodeURL.
+type AuthCodeOption func(*authCodeParams)
+
+// authCodeParams - parameters of the authorization URL
+type authCodeParams struct {
+	state       string
+	binding     string
+	redirectURI string
+	pkce        bool
+	values      url.Values
+}
+
+// WithState sets a custom state instead of a signed random state.
+func WithState(state string) AuthCodeOption {
+	return func(p *authCodeParams) {
+		p.state = state
+	}
+}
+
+// WithStateBinding binds the signed state to the browser which starts the flow,
+// e.g. to the ID of its session cookie. Verify the state with App.VerifyBoundState
+// and the same binding, read from the request of the callback.
+func WithStateBinding(binding string) AuthCodeOption {
+	return func(p *authCodeParams) {
+		p.binding = binding
+	}
+}
+
+// WithRedirectURI overrides App.RedirectURI.
+func WithRedirectURI(redirectURI string) AuthCodeOption {
+	return func(p *authCodeParams) {
+		p.redirectURI = redirectURI
+	}
+}
+
+// WithNonce adds a nonce to the authorization URL, which LinkedIn includes
+// in the ID token. Check it with IDTokenVerifier.Verify.
+func WithNonce(nonce string) AuthCodeOption {
+	return func(p *authCodeParams) {
+		p.values.Set("nonce", nonce)
+	}
+}
+
+// WithPKCE adds the code challenge of p to the authorization URL and
+// uses the authorization endpoint of the native PKCE flow, /oauth/native-pkce/authorization.
+// Redeem the authorization code with App.ParseCodeWithVerifier.
+func WithPKCE(p PKCE) AuthCodeOption {
+	return func(params *authCodeParams) {
+		params.pkce = true
+		params.values.Set("code_challenge", p.Challenge)
+		params.values.Set("code_challenge_method", p.Method)
+	}
+}
+
+// PKCE - Proof Key for Code Exchange parameters for public clients.
+//
+// See: https://learn.microsoft.com/en-us/linkedin/shared/authentication/authorization-code-flow-native
+type PKCE struct {
+	Verifier  string // code_verifier, keep it until the code is redeemed
+	Challenge string // code_challenge sent in the authorization URL
+	Method    string // code_challenge_method, always S256
+}
+
+// NewPKCE generates a random code verifier and its S256 code challenge.
+func NewPKCE() (PKCE, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return PKCE{}, fmt.Errorf("linkedIn: cannot generate code verifier; %w", err)
+	}
+
+	verifier := base64.RawURLEncoding.EncodeToString(b)
+	sum := sha256.Sum256([]byte(verifier))
+	return PKCE{
+		Verifier:  verifier,
+		Challenge: base64.RawURLEncoding.EncodeToString(sum[:]),
+		Method:    "S256",
+	}, nil
+}
+
+// AuthCodeURL returns the URL of the LinkedIn authorization page to start
+// the 3-legged OAuth flow, and the state included in it.
+//
+// Unless WithState is given, the state is a random value signed by App.NewBoundState,
+// so that the callback handler can check it with App.VerifyBoundState.
+// Use WithStateBinding to bind the state to the browser, see App.NewBoundState.
+// With WithPKCE, the URL points to the authorization endpoint of the native PKCE flow.
+// Public clients without StateKey and ClientSecret get an unsigned random state,
+// which must be stored, e.g. with CallbackHandler.SetStateCookie, and compared in the callback.
+//
+// See: https://learn.microsoft.com/en-us/linkedin/shared/authentication/authorization-code-flow?context=linkedin%2Fcontext&tabs=HTTPS1#step-2-request-an-authorization-code
+func (app *App) AuthCodeURL(scopes []string, opts ...AuthCodeOption) (authURL, state string, err error) {
+	p := &authCodeParams{
+		redirectURI: app.RedirectURI,
+		values:      url.Values{},
+	}
+	for _, opt := range opts {
+		opt(p)
+	}
+
+	if p.redirectURI == "" {
+		return "", "", fmt.Errorf("linkedIn: redirect_uri is required to build authorization URL")
+	}
+	if len(scopes) == 0 {
+		return "", "", fmt.Errorf("linkedIn: at least one scope is required to build authorization URL")
+	}
+
+	state = p.state
+	if state == "" {
+		if _, keyErr := app.stateKey(); keyErr != nil && p.binding == "" {
+			state, err = randomState()
+		} else {
+			state, err = app.NewBoundState(p.binding, DefaultStateTTL)
+		}
+		if err != nil {
+			return "", "", err
+		}
+	}
+
+	p.values.Set("response_type", "code")
+	p.values.Set("client_id", app.ClientID)
+	p.values.Set("redirect_uri", p.redirectURI)
+	p.values.Set("state", state)
+	p.values.Set("scope", strings.Join(scopes, " "))
+
+	authorizationURL := app.oauthBaseURL() + "/authorization"
+	if p.pkce {
+		authorizationURL = app.nativePKCEURL() + "/authorization"
+	}
+	return authorizationURL + "?" + p.values.Encode(), state, nil
+}
+
+// nativePKCEURL returns the base URL of the native PKCE endpoints,
+// a sibling of the OAuth base URL, e.g. https://www.linkedin.com/oauth/native-pkce.
+func (app *App) nativePKCEURL() string {
+	base := app.oauthBaseURL()
+	if i := strings.LastIndex(base, "/"); i > len("https://") {
+		base = base[:i]
+	}
+	return base + "/native-pkce"
+}
+
+// NewState returns a random state signed with HMAC-SHA256, valid for ttl.
+//
+// The state is not bound to a browser, so it is not CSRF protection on its own:
+// anyone can request a valid state and pass it to a victim together with their
+// own authorization code. Use NewBoundState, or store the state in a cookie and
+// compare it in the callback, as CallbackHandler does.
+func (app *App) NewState(ttl time.Duration) (string, error) {
+	return app.NewBoundState("", ttl)
+}
+
+// NewBoundState returns a random state valid for ttl, signed with HMAC-SHA256
+// together with binding, a value identifying the browser which starts the flow,
+// e.g. the ID of its session cookie.
+//
+// The state is signed with App.StateKey, or with ClientSecret if StateKey is empty,
+// so it can be verified without server-side storage. It is not single-use,
+// the binding should be rotated after a successful login.
+func (app *App) NewBoundState(binding string, ttl time.Duration) (string, error) {
+	key, err := app.stateKey()
+	if err != nil {
+		return "", err
+	}
+
+	payload := make([]byte, 24)
+	if _, err := rand.Read(payload[:16]); err != nil {
+		return "", fmt.Errorf("linkedIn: cannot generate state; %w", err)
+	}
+	binary.BigEndian.PutUint64(payload[16:], uint64(time.Now().Add(ttl).Unix()))
+
+	return encodeState(payload) + "." + encodeState(signState(key, payload, binding)), nil
+}
+
+// VerifyState checks the signature and expiry of a state created by App.NewState.
+func (app *App) VerifyState(state string) error {
+	return app.VerifyBoundState(state, "")
+}
+
+// VerifyBoundState checks the signature, binding and expiry of a state
+// created by App.NewBoundState.
+func (app *App) VerifyBoundState(state, binding string) error {
+	key, err := app.stateKey()
+	if err != nil {
+		return err
+	}
+
+	encodedPayload, encodedSignature, ok := strings.Cut(state, ".")
+	if !ok {
+		return ErrInvalidState
+	}
+	payload, err := base64.RawURLEncoding.DecodeString(encodedPayload)
+	if err != nil || len(payload) != 24 {
+		return ErrInvalidState
+	}
+	signature, err := base64.RawURLEncoding.DecodeString(encodedSignature)
+	if err != nil || !hmac.Equal(signature, signState(key, payload, binding)) {
+		return ErrInvalidState
+	}
+
+	expiresAt := time.Unix(int64(binary.BigEndian.Uint64(payload[16:])), 0)
+	if time.Now().After(expiresAt) {
+		return ErrStateExpired
+	}
+	return nil
+}
+
+// stateKey returns the key to sign states.
+func (app *App) stateKey() ([]byte, error) {
+	if len(app.StateKey) > 0 {
+		return app.StateKey, nil
+	}
+	if app.ClientSecret != "" {
+		return []byte(app.ClientSecret), nil
+	}
+	return nil, fmt.Errorf("linkedIn: state key or client secret is required to sign state")
+}
+
+// randomState returns an unsigned random state.
+func randomState() (string, error) {
+	b := make([]byte, 24)
+	if _, err := rand.Read(b); err != nil {
+		return "", fmt.Errorf("linkedIn: cannot generate state; %w", err)
+	}
+	return encodeState(b), nil
+}
+
+// signState returns the HMAC-SHA256 signature of payload and binding.
+func signState(key, payload []byte, binding string) []byte {
+	mac := hmac.New(sha256.New, key)
+	mac.Write(payload)
+	mac.Write([]byte(binding))
+	return mac.Sum(nil)
+}
+
+// encodeState encodes b in URL-safe base64 without padding.
+func encodeState(b []byte) string {
+	return base64.RawURLEncoding.EncodeToString(b)
+}
@@ -0,0 +1,114 @@
+package linkedin
+
+import (
+	"errors"
+	"net/url"
+	"testing"
+	"time"
+)
+
+// TestAuthCodeURL tests building the authorization URL
+func TestAuthCodeURL(t *testing.T) {
+	app := New("client", "secret")
+	app.RedirectURI = "https://example.com/callback"
+
+	authURL, state, err := app.AuthCodeURL([]string{"openid", "profile"})
+	if err != nil {
+		t.Fatalf("AuthCodeURL() error = %v", err)
+	}
+
+	u, err := url.Parse(authURL)
+	if err != nil {
+		t.Fatalf("url.Parse(%s) error = %v", authURL, err)
+	}
+	query := u.Query()
+	if u.Scheme+"://"+u.Host+u.Path != OauthBaseURL+"/authorization" {
+		t.Errorf("authorization URL = %s; want %s/authorization", authURL, OauthBaseURL)
+	}
+	if query.Get("response_type") != "code" || query.Get("client_id") != "client" ||
+		query.Get("redirect_uri") != app.RedirectURI || query.Get("scope") != "openid profile" ||
+		query.Get("state") != state {
+		t.Errorf("unexpected query %v", query)
+	}
+	if err := app.VerifyState(state); err != nil {
+		t.Errorf("VerifyState() error = %v; want nil", err)
+	}
+
+	if _, state, _ := app.AuthCodeURL([]string{"openid"}, WithState("custom")); state != "custom" {
+		t.Errorf("state = %s; want custom", state)
+	}
+	if _, _, err := New("client", "secret").AuthCodeURL([]string{"openid"}); err == nil {
+		t.Errorf("AuthCodeURL() without redirect URI error = nil; want error")
+	}
+}
+
+// TestVerifyState tests rejecting tampered and expired states
+func TestVerifyState(t *testing.T) {
+	app := New("client", "secret")
+
+	state, err := app.NewState(time.Minute)
+	if err != nil {
+		t.Fatalf("NewState() error = %v", err)
+	}
+	if err := New("client", "other").VerifyState(state); !errors.Is(err, ErrInvalidState) {
+		t.Errorf("VerifyState() with other key error = %v; want ErrInvalidState", err)
+	}
+	if err := app.VerifyState("x" + state); !errors.Is(err, ErrInvalidState) {
+		t.Errorf("VerifyState() tampered error = %v; want ErrInvalidState", err)
+	}
+	if err := app.VerifyState("foobar"); !errors.Is(err, ErrInvalidState) {
+		t.Errorf("VerifyState() unsigned error = %v; want ErrInvalidState", err)
+	}
+
+	expired, _ := app.NewState(-time.Minute)
+	if err := app.VerifyState(expired); !errors.Is(err, ErrStateExpired) {
+		t.Errorf("VerifyState() expired error = %v; want ErrStateExpired", err)
+	}
+}
+
+// TestVerifyBoundState tests rejecting a state issued to another browser
+func TestVerifyBoundState(t *testing.T) {
+	app := New("client", "secret")
+	app.RedirectURI = "https://example.com/callback"
+
+	_, state, err := app.AuthCodeURL([]string{"openid"}, WithStateBinding("session-a"))
+	if err != nil {
+		t.Fatalf("AuthCodeURL() error = %v", err)
+	}
+	if err := app.VerifyBoundState(state, "session-a"); err != nil {
+		t.Errorf("VerifyBoundState() error = %v; want nil", err)
+	}
+	if err := app.VerifyBoundState(state, "session-b"); !errors.Is(err, ErrInvalidState) {
+		t.Errorf("VerifyBoundState() other binding error = %v; want ErrInvalidState", err)
+	}
+	if err := app.VerifyState(state); !errors.Is(err, ErrInvalidState) {
+		t.Errorf("VerifyState() unbound error = %v; want ErrInvalidState", err)
+	}
+}
+
+// TestPKCE tests the code challenge in the authorization URL
+func TestPKCE(t *testing.T) {
+	p, err := NewPKCE()
+	if err != nil {
+		t.Fatalf("NewPKCE() error = %v", err)
+	}
+	if len(p.Verifier) != 43 || p.Method != "S256" {
+		t.Errorf("NewPKCE() = %+v; want 43 characters verifier and S256", p)
+	}
+
+	app := New("client", "")
+	app.StateKey = []byte("state-key")
+	app.RedirectURI = "http://127.0.0.1:8080/callback"
+	authURL, _, err := app.AuthCodeURL([]string{"openid"}, WithPKCE(p))
+	if err != nil {
+		t.Fatalf("AuthCodeURL() error = %v", err)
+	}
+
+	u, _ := url.Parse(authURL)
+	if want := "https://www.linkedin.com/oauth/native-pkce/authorization"; u.Scheme+"://"+u.Host+u.Path != want {
+		t.Errorf("authorization URL = %s; want %s", authURL, want)
+	}
+	if u.Query().Get("code_challenge") != p.Challenge || u.Query().Get("code_challenge_method") != "S256" {
+		t.Errorf("unexpected query %v", u.Query())
+	}
+}
@@ -0,0 +1,167 @@
+package linkedin
+
+import (
+	"crypto/subtle"
+	"errors"
+	"fmt"
+	"net/http"
+)
+
+// DefaultStateCookie - name of the cookie which binds the state to the browser
+const DefaultStateCookie = "linkedin_oauth_state"
+
+// ErrUserCancelled matches an AuthorizationError for a member who
+// cancelled the login or declined the authorization request.
+var ErrUserCancelled = errors.New("linkedIn: user cancelled the authorization")
+
+// AuthorizationError - error passed by LinkedIn to the redirect URI
+//
+// See: https://learn.microsoft.com/en-us/linkedin/shared/authentication/authorization-code-flow?context=linkedin%2Fcontext&tabs=HTTPS1#application-is-rejected
+type AuthorizationError struct {
+	Code        string // e.g. user_cancelled_login, user_cancelled_authorize
+	Description string
+}
+
+// Error implements the error interface.
+func (e *AuthorizationError) Error() string {
+	if e.Description == "" {
+		return "linkedIn: authorization failed with " + e.Code
+	}
+	return fmt.Sprintf("linkedIn: authorization failed with %s: %s", e.Code, e.Description)
+}
+
+// Is reports whether the member cancelled the authorization, see ErrUserCancelled.
+func (e *AuthorizationError) Is(target error) bool {
+	return target == ErrUserCancelled &&
+		(e.Code == "user_cancelled_login" || e.Code == "user_cancelled_authorize")
+}
+
+// CallbackHandler is an http.Handler for the redirect URI of the 3-legged OAuth flow.
+//
+// It verifies the state, exchanges the authorization code for tokens and
+// calls OnSuccess with the tokens, or OnError with the reason of the failure:
+// an *AuthorizationError sent by LinkedIn, ErrInvalidState, ErrStateExpired,
+// or the error of the token exchange.
+//
+// By default, the state must match the state cookie set by SetStateCookie
+// in the browser which started the flow, which prevents login CSRF.
+// The cookie is removed by the callback, so each state is accepted once.
+type CallbackHandler struct {
+	App *App
+
+	// StateCookie is the name of the state cookie, DefaultStateCookie if empty.
+	StateCookie string
+	// VerifyState checks the state instead of the state cookie, optional.
+	// It must bind the state to the browser, e.g. with App.VerifyBoundState.
+	VerifyState func(r *http.Request, state string) error
+	// CodeVerifier returns the PKCE code verifier for the state, if PKCE is used.
+	CodeVerifier func(r *http.Request, state string) (string, error)
+
+	// OnSuccess is called with the tokens, it must write the response. Required.
+	OnSuccess func(w http.ResponseWriter, r *http.Request, token Token)
+	// OnError is called on failure, a plain text error response is written if nil.
+	OnError func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+// SetStateCookie stores the state returned by App.AuthCodeURL in a cookie,
+// before the browser is redirected to the authorization URL.
+func (h *CallbackHandler) SetStateCookie(w http.ResponseWriter, state string) {
+	http.SetCookie(w, &http.Cookie{
+		Name:     h.stateCookie(),
+		Value:    state,
+		Path:     "/",
+		MaxAge:   int(DefaultStateTTL.Seconds()),
+		Secure:   true,
+		HttpOnly: true,
+		SameSite: http.SameSiteLaxMode,
+	})
+}
+
+// ServeHTTP implements http.Handler.
+func (h *CallbackHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if h.App == nil || h.OnSuccess == nil {
+		http.Error(w, "linkedIn: callback handler requires App and OnSuccess", http.StatusInternalServerError)
+		return
+	}
+
+	query := r.URL.Query()
+	state := query.Get("state")
+
+	if code := query.Get("error"); code != "" {
+		h.fail(w, r, &AuthorizationError{Code: code, Description: query.Get("error_description")})
+		return
+	}
+
+	verify := h.VerifyState
+	if verify == nil {
+		verify = func(r *http.Request, state string) error {
+			return h.verifyStateCookie(w, r, state)
+		}
+	}
+	if err := verify(r, state); err != nil {
+		h.fail(w, r, err)
+		return
+	}
+
+	var token Token
+	var err error
+	if h.CodeVerifier != nil {
+		var verifier string
+		verifier, err = h.CodeVerifier(r, state)
+		if err == nil {
+			token, err = h.App.ParseCodeWithVerifier(query.Get("code"), verifier)
+		}
+	} else {
+		token, err = h.App.ParseCode(query.Get("code"))
+	}
+	if err != nil {
+		h.fail(w, r, err)
+		return
+	}
+
+	h.OnSuccess(w, r, token)
+}
+
+// verifyStateCookie compares state with the state cookie and removes the cookie.
+// The cookie expires after DefaultStateTTL.
+func (h *CallbackHandler) verifyStateCookie(w http.ResponseWriter, r *http.Request, state string) error {
+	cookie, err := r.Cookie(h.stateCookie())
+	if err != nil {
+		return ErrInvalidState
+	}
+	http.SetCookie(w, &http.Cookie{
+		Name:     h.stateCookie(),
+		Path:     "/",
+		MaxAge:   -1,
+		Secure:   true,
+		HttpOnly: true,
+		SameSite: http.SameSiteLaxMode,
+	})
+
+	if state == "" || subtle.ConstantTimeCompare([]byte(cookie.Value), []byte(state)) != 1 {
+		return ErrInvalidState
+	}
+	return nil
+}
+
+// stateCookie returns the name of the state cookie.
+func (h *CallbackHandler) stateCookie() string {
+	if h.StateCookie != "" {
+		return h.StateCookie
+	}
+	return DefaultStateCookie
+}
+
+// fail reports an error to OnError.
+func (h *CallbackHandler) fail(w http.ResponseWriter, r *http.Request, err error) {
+	if h.OnError != nil {
+		h.OnError(w, r, err)
+		return
+	}
+
+	status := http.StatusBadRequest
+	if _, ok := AsAPIError(err); ok {
+		status = http.StatusBadGateway
+	}
+	http.Error(w, err.Error(), status)
+}
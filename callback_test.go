@@ -0,0 +1,111 @@
+package linkedin
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+)
+
+// newCallbackTestServer returns a server running a CallbackHandler
+// whose app exchanges codes at a fake token endpoint
+func newCallbackTestServer(t *testing.T, callbackErr *error) (*App, *httptest.Server) {
+	app := newTokenEndpointApp(t, nil, func(form url.Values) (int, string) {
+		if form.Get("code") != "valid" {
+			return http.StatusBadRequest, `{"error":"invalid_request","error_description":"Unable to retrieve access token"}`
+		}
+		return http.StatusOK, `{"access_token":"access","expires_in":5184000,"scope":"openid"}`
+	})
+
+	handler := &CallbackHandler{
+		App: app,
+		OnSuccess: func(w http.ResponseWriter, r *http.Request, token Token) {
+			fmt.Fprint(w, token.AccessToken)
+		},
+		OnError: func(w http.ResponseWriter, r *http.Request, err error) {
+			*callbackErr = err
+			w.WriteHeader(http.StatusBadRequest)
+		},
+	}
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+	return app, server
+}
+
+// TestCallbackHandler tests the OAuth redirect handler end to end
+func TestCallbackHandler(t *testing.T) {
+	var callbackErr error
+	app, server := newCallbackTestServer(t, &callbackErr)
+	_, state, _ := app.AuthCodeURL([]string{"openid"})
+	_, attackerState, _ := app.AuthCodeURL([]string{"openid"})
+
+	tests := []struct {
+		name   string
+		query  url.Values
+		cookie string
+		status int
+		check  func(err error) bool
+	}{
+		{"success", url.Values{"code": {"valid"}, "state": {state}}, state, http.StatusOK, func(err error) bool { return err == nil }},
+		{"cancelled", url.Values{"error": {"user_cancelled_login"}, "error_description": {"The user cancelled LinkedIn login"}, "state": {state}}, state, http.StatusBadRequest, func(err error) bool { return errors.Is(err, ErrUserCancelled) }},
+		{"invalid state", url.Values{"code": {"valid"}, "state": {"forged"}}, state, http.StatusBadRequest, func(err error) bool { return errors.Is(err, ErrInvalidState) }},
+		{"missing cookie", url.Values{"code": {"valid"}, "state": {state}}, "", http.StatusBadRequest, func(err error) bool { return errors.Is(err, ErrInvalidState) }},
+		{"login CSRF", url.Values{"code": {"valid"}, "state": {attackerState}}, state, http.StatusBadRequest, func(err error) bool { return errors.Is(err, ErrInvalidState) }},
+		{"invalid code", url.Values{"code": {"invalid"}, "state": {state}}, state, http.StatusBadRequest, func(err error) bool {
+			apiErr, ok := AsAPIError(err)
+			return ok && apiErr.OAuthError == "invalid_request"
+		}},
+	}
+
+	for _, test := range tests {
+		callbackErr = nil
+		request, _ := http.NewRequest(http.MethodGet, server.URL+"?"+test.query.Encode(), nil)
+		if test.cookie != "" {
+			request.AddCookie(&http.Cookie{Name: DefaultStateCookie, Value: test.cookie})
+		}
+		response, err := http.DefaultClient.Do(request)
+		if err != nil {
+			t.Fatalf("%s: GET error = %v", test.name, err)
+		}
+		response.Body.Close()
+
+		if response.StatusCode != test.status || !test.check(callbackErr) {
+			t.Errorf("%s: status = %d, error = %v; want %d", test.name, response.StatusCode, callbackErr, test.status)
+		}
+	}
+}
+
+// TestCallbackHandlerStateCookie tests setting the state cookie and removing it in the callback
+func TestCallbackHandlerStateCookie(t *testing.T) {
+	handler := &CallbackHandler{App: New("client", "secret")}
+
+	recorder := httptest.NewRecorder()
+	handler.SetStateCookie(recorder, "state-1")
+	cookies := recorder.Result().Cookies()
+	if len(cookies) != 1 || cookies[0].Name != DefaultStateCookie || cookies[0].Value != "state-1" || !cookies[0].HttpOnly {
+		t.Fatalf("SetStateCookie() cookies = %v; want HttpOnly %s", cookies, DefaultStateCookie)
+	}
+
+	recorder = httptest.NewRecorder()
+	request := httptest.NewRequest(http.MethodGet, "/callback?state=state-1", nil)
+	request.AddCookie(cookies[0])
+	if err := handler.verifyStateCookie(recorder, request, "state-1"); err != nil {
+		t.Errorf("verifyStateCookie() error = %v; want nil", err)
+	}
+	if removed := recorder.Result().Cookies(); len(removed) != 1 || removed[0].MaxAge >= 0 {
+		t.Errorf("verifyStateCookie() cookies = %v; want the state cookie removed", removed)
+	}
+}
+
+// TestCallbackHandlerMisconfigured tests the response without OnSuccess
+func TestCallbackHandlerMisconfigured(t *testing.T) {
+	handler := &CallbackHandler{App: New("client", "secret")}
+
+	recorder := httptest.NewRecorder()
+	handler.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/callback?code=valid&state=x", nil))
+	if recorder.Code != http.StatusInternalServerError {
+		t.Errorf("ServeHTTP() status = %d; want 500", recorder.Code)
+	}
+}
@@ -0,0 +1,151 @@
+package linkedin
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// APIError - error returned by LinkedIn for a non-2xx response.
+//
+// Versioned APIs report `serviceErrorCode`, `code`, `message` and `status`,
+// while OAuth endpoints report `error` and `error_description`.
+//
+// See: https://learn.microsoft.com/en-us/linkedin/shared/api-guide/concepts/error-handling
+type APIError struct {
+	StatusCode       int          `json:"-"`                // HTTP status code
+	RequestID        string       `json:"-"`                // value of the `x-li-uuid` response header
+	Body             []byte       `json:"-"`                // raw response body
+	ServiceErrorCode int          `json:"serviceErrorCode"` // e.g. 100
+	Code             string       `json:"code"`             // e.g. ACCESS_DENIED
+	Message          string       `json:"message"`
+	Status           int          `json:"status"`            // e.g. 403
+	OAuthError       string       `json:"error"`             // e.g. invalid_request
+	ErrorDescription string       `json:"error_description"` // e.g. A required parameter "code" is missing
+	ErrorDetails     ErrorDetails `json:"errorDetails"`
+}
+
+// ErrorDetails struct for field-level errors
+type ErrorDetails struct {
+	InputErrors []InputError `json:"inputErrors"`
+}
+
+// InputError struct for an error caused by a request field
+type InputError struct {
+	Code        string     `json:"code"` // e.g. FIELD_VALUE_TOO_LONG
+	Description string     `json:"description"`
+	Input       InputField `json:"input"`
+}
+
+// InputField struct for the request field causing an error
+type InputField struct {
+	InputPath InputPath `json:"inputPath"`
+}
+
+// InputPath struct for the path of the request field causing an error
+type InputPath struct {
+	FieldPath string `json:"fieldPath"` // e.g. /commentary
+}
+
+// newAPIError parses an error response from LinkedIn.
+func newAPIError(response *http.Response, body []byte) *APIError {
+	apiErr := &APIError{}
+	// error responses are not guaranteed to be JSON, keep the raw body
+	_ = json.Unmarshal(body, apiErr)
+
+	apiErr.StatusCode = response.StatusCode
+	apiErr.RequestID = response.Header.Get(string(RequestID))
+	apiErr.Body = body
+	return apiErr
+}
+
+// Error implements the error interface.
+func (e *APIError) Error() string {
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "linkedIn: request failed with status %d", e.StatusCode)
+
+	if e.Code != "" {
+		fmt.Fprintf(&sb, " %s", e.Code)
+	}
+	if e.ServiceErrorCode != 0 {
+		fmt.Fprintf(&sb, " (serviceErrorCode %d)", e.ServiceErrorCode)
+	}
+	if e.OAuthError != "" {
+		fmt.Fprintf(&sb, " %s", e.OAuthError)
+	}
+
+	message := e.Message
+	if message == "" {
+		message = e.ErrorDescription
+	}
+	if message != "" {
+		fmt.Fprintf(&sb, ": %s", message)
+	}
+
+	for _, inputErr := range e.ErrorDetails.InputErrors {
+		fmt.Fprintf(&sb, "; %s %s", inputErr.Input.InputPath.FieldPath, inputErr.Code)
+	}
+
+	if e.RequestID != "" {
+		fmt.Fprintf(&sb, " [x-li-uuid: %s]", e.RequestID)
+	}
+	return sb.String()
+}
+
+// IsRateLimited reports whether the request was throttled.
+func (e *APIError) IsRateLimited() bool {
+	return e.StatusCode == http.StatusTooManyRequests
+}
+
+// IsUnauthorized reports whether the access token is missing, invalid or expired.
+func (e *APIError) IsUnauthorized() bool {
+	return e.StatusCode == http.StatusUnauthorized
+}
+
+// IsPermissionDenied reports whether the member or application lacks the permission.
+func (e *APIError) IsPermissionDenied() bool {
+	return e.StatusCode == http.StatusForbidden
+}
+
+// IsVersionSunset reports whether the requested LinkedIn-Version is missing,
+// not active or sunset.
+//
+// See: https://learn.microsoft.com/en-us/linkedin/marketing/versioning
+func (e *APIError) IsVersionSunset() bool {
+	return e.StatusCode == http.StatusUpgradeRequired ||
+		e.Code == "NONEXISTENT_VERSION" ||
+		e.Code == "VERSION_MISSING"
+}
+
+// AsAPIError finds the first *APIError in the chain of err.
+func AsAPIError(err error) (*APIError, bool) {
+	var apiErr *APIError
+	ok := errors.As(err, &apiErr)
+	return apiErr, ok
+}
+
+// IsRateLimited reports whether err is an *APIError for a throttled request.
+func IsRateLimited(err error) bool {
+	apiErr, ok := AsAPIError(err)
+	return ok && apiErr.IsRateLimited()
+}
+
+// IsUnauthorized reports whether err is an *APIError for an unauthorized request.
+func IsUnauthorized(err error) bool {
+	apiErr, ok := AsAPIError(err)
+	return ok && apiErr.IsUnauthorized()
+}
+
+// IsPermissionDenied reports whether err is an *APIError for a forbidden request.
+func IsPermissionDenied(err error) bool {
+	apiErr, ok := AsAPIError(err)
+	return ok && apiErr.IsPermissionDenied()
+}
+
+// IsVersionSunset reports whether err is an *APIError for an inactive LinkedIn-Version.
+func IsVersionSunset(err error) bool {
+	apiErr, ok := AsAPIError(err)
+	return ok && apiErr.IsVersionSunset()
+}
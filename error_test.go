@@ -0,0 +1,35 @@
+package linkedin
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+)
+
+// TestNewAPIError tests parsing LinkedIn error responses
+func TestNewAPIError(t *testing.T) {
+	response := &http.Response{
+		StatusCode: http.StatusUnprocessableEntity,
+		Header:     http.Header{"X-Li-Uuid": []string{"abc123"}},
+	}
+	body := []byte(`{"errorDetailType":"com.linkedin.common.error.BadRequest","message":"Invalid request","errorDetails":{"inputErrors":[{"description":"too long","input":{"inputPath":{"fieldPath":"/commentary"}},"code":"FIELD_VALUE_TOO_LONG"}]},"status":422,"code":"UNPROCESSABLE_ENTITY","serviceErrorCode":100}`)
+
+	apiErr := newAPIError(response, body)
+	if apiErr.RequestID != "abc123" {
+		t.Errorf("RequestID = %s; want abc123", apiErr.RequestID)
+	}
+	if apiErr.ServiceErrorCode != 100 || apiErr.Code != "UNPROCESSABLE_ENTITY" || apiErr.Status != 422 {
+		t.Errorf("unexpected error fields: %+v", apiErr)
+	}
+	if len(apiErr.ErrorDetails.InputErrors) != 1 || apiErr.ErrorDetails.InputErrors[0].Input.InputPath.FieldPath != "/commentary" {
+		t.Errorf("unexpected error details: %+v", apiErr.ErrorDetails)
+	}
+
+	err := fmt.Errorf("wrapped: %w", &APIError{StatusCode: http.StatusTooManyRequests})
+	if !IsRateLimited(err) {
+		t.Errorf("IsRateLimited(%v) = false; want true", err)
+	}
+	if IsUnauthorized(err) {
+		t.Errorf("IsUnauthorized(%v) = true; want false", err)
+	}
+}
@@ -0,0 +1,178 @@
+package linkedin
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+)
+
+// DefaultMaxImageSize is the default upper limit of uploaded images in bytes.
+const DefaultMaxImageSize int64 = 20 << 20
+
+// ImageContentTypes lists the image formats accepted by the Images API.
+var ImageContentTypes = []string{"image/jpeg", "image/png", "image/gif"}
+
+// ImagesService - LinkedIn Images API
+//
+// See: https://learn.microsoft.com/en-us/linkedin/marketing/community-management/shares/images-api
+type ImagesService struct {
+	session *Session
+}
+
+// Images returns the Images API service of the session.
+func (session *Session) Images() *ImagesService {
+	return &ImagesService{session: session}
+}
+
+// ElementImage struct for LinkedIn images
+type ElementImage struct {
+	ID                   string `json:"id"`     // e.g. urn:li:image:C4E10AQ
+	Owner                string `json:"owner"`  // e.g. urn:li:organization:123456
+	Status               string `json:"status"` // e.g. AVAILABLE
+	DownloadURL          string `json:"downloadUrl,omitempty"`
+	DownloadURLExpiresAt int64  `json:"downloadUrlExpiresAt,omitempty"`
+	UploadedAt           int64  `json:"uploadedAt,omitempty"`
+}
+
+// UploadImageRequest struct for uploading an image
+type UploadImageRequest struct {
+	Owner        URN           // person or organization, e.g. urn:li:organization:123456
+	Reader       io.Reader     // JPG, PNG or GIF image, streamed to LinkedIn
+	Size         int64         // size in bytes if known, sent as Content-Length
+	MaxSize      int64         // DefaultMaxImageSize if 0
+	AltText      string        // alternative text used when the image is attached to a post
+	PollInterval time.Duration // DefaultPollInterval if 0
+	PollTimeout  time.Duration // DefaultPollTimeout if 0
+}
+
+// UploadedImage struct for an image which is ready to be used in a post
+type UploadedImage struct {
+	URN     URN    // e.g. urn:li:image:C4E10AQ
+	AltText string // alternative text from the upload request
+}
+
+// Content returns the post content to attach the image to a post.
+func (img UploadedImage) Content() *ContentPost {
+	return &ContentPost{
+		Media: &MediaContentPost{
+			ID:      img.URN.String(),
+			AltText: img.AltText,
+		},
+	}
+}
+
+// initializeUploadImage struct for the response of the initializeUpload action
+type initializeUploadImage struct {
+	Value struct {
+		UploadURL          string `json:"uploadUrl"`
+		UploadURLExpiresAt int64  `json:"uploadUrlExpiresAt"`
+		Image              string `json:"image"`
+	} `json:"value"`
+}
+
+// Upload uploads an image and waits until LinkedIn has processed it.
+//
+// The image is registered with the initializeUpload action, streamed
+// to the returned upload URL and polled until it is AVAILABLE.
+func (s *ImagesService) Upload(ctx context.Context, req UploadImageRequest) (UploadedImage, error) {
+	if ctx == nil {
+		ctx = s.session.Context()
+	}
+	if req.Owner.IsZero() {
+		return UploadedImage{}, fmt.Errorf("linkedIn: image owner is required")
+	}
+	if req.Reader == nil {
+		return UploadedImage{}, fmt.Errorf("linkedIn: image reader is required")
+	}
+	maxSize := req.MaxSize
+	if maxSize <= 0 {
+		maxSize = DefaultMaxImageSize
+	}
+	if req.Size > maxSize {
+		return UploadedImage{}, fmt.Errorf("linkedIn: image size %d exceeds the limit of %d bytes", req.Size, maxSize)
+	}
+	if err := s.session.RequireScopes(PostWriteScopes(req.Owner)...); err != nil {
+		return UploadedImage{}, err
+	}
+
+	// sniff the content type before registering the upload
+	body := bufio.NewReaderSize(req.Reader, 512)
+	head, err := body.Peek(512)
+	if err != nil && !errors.Is(err, io.EOF) {
+		return UploadedImage{}, fmt.Errorf("linkedIn: cannot read image; %w", err)
+	}
+	if len(head) == 0 {
+		return UploadedImage{}, fmt.Errorf("linkedIn: image is empty")
+	}
+	contentType := http.DetectContentType(head)
+	if !isImageContentType(contentType) {
+		return UploadedImage{}, fmt.Errorf("linkedIn: unsupported image content type %s", contentType)
+	}
+
+	// register the upload
+	response, err := s.session.Do(ctx, Action, "/images?action=initializeUpload", nil, Params{
+		"initializeUploadRequest": Params{
+			"owner": req.Owner.String(),
+		},
+	})
+	if err != nil {
+		return UploadedImage{}, err
+	}
+	var initialized initializeUploadImage
+	if err := response.Decode(&initialized); err != nil {
+		return UploadedImage{}, err
+	}
+	urn, err := ParseURN(initialized.Value.Image)
+	if err != nil {
+		return UploadedImage{}, err
+	}
+	if initialized.Value.UploadURL == "" {
+		return UploadedImage{}, fmt.Errorf("linkedIn: upload URL is missing for %s", urn)
+	}
+
+	// stream the image
+	limited := &limitedReader{r: body, n: maxSize}
+	if _, err := s.session.upload(ctx, initialized.Value.UploadURL, contentType, limited, req.Size); err != nil {
+		if limited.exceeded {
+			return UploadedImage{}, fmt.Errorf("linkedIn: image exceeds the limit of %d bytes", maxSize)
+		}
+		return UploadedImage{}, err
+	}
+
+	// wait until the image can be used in a post
+	err = pollStatus(ctx, req.PollInterval, req.PollTimeout, func(ctx context.Context) (string, error) {
+		image, err := s.Get(ctx, urn)
+		return image.Status, err
+	})
+	if err != nil {
+		return UploadedImage{}, err
+	}
+
+	return UploadedImage{URN: urn, AltText: req.AltText}, nil
+}
+
+// Get returns the image with the given URN, e.g. urn:li:image:C4E10AQ
+func (s *ImagesService) Get(ctx context.Context, urn URN) (ElementImage, error) {
+	response, err := s.session.Do(ctx, Get, "/images/"+urn.RestLiString(), nil, nil)
+	if err != nil {
+		return ElementImage{}, err
+	}
+
+	var image ElementImage
+	err = response.Decode(&image)
+	return image, err
+}
+
+// isImageContentType reports whether contentType is accepted by the Images API.
+func isImageContentType(contentType string) bool {
+	for _, t := range ImageContentTypes {
+		if t == contentType {
+			return true
+		}
+	}
+	return false
+}
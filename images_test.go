@@ -0,0 +1,152 @@
+package linkedin
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+)
+
+// pngHeader is the signature of a PNG image
+var pngHeader = []byte("\x89PNG\r\n\x1a\n\x00\x00\x00\rIHDR")
+
+// newImagesTestServer returns a fake Images API which accepts the upload
+// and reports the image as available after the given number of polls.
+func newImagesTestServer(t *testing.T, processingPolls int, uploaded *bytes.Buffer) *Session {
+	var server *httptest.Server
+	server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodPost && r.URL.Path == "/images":
+			if r.URL.Query().Get("action") != "initializeUpload" {
+				t.Errorf("unexpected query %s", r.URL.RawQuery)
+			}
+			var body map[string]map[string]string
+			_ = json.NewDecoder(r.Body).Decode(&body)
+			if body["initializeUploadRequest"]["owner"] != "urn:li:organization:5515715" {
+				t.Errorf("unexpected request body %v", body)
+			}
+			_, _ = w.Write([]byte(`{"value":{"uploadUrl":"` + server.URL + `/upload","image":"urn:li:image:C4E10AQ"}}`))
+		case r.Method == http.MethodPut && r.URL.Path == "/upload":
+			if r.Header.Get(string(Authorization)) != "Bearer token" || r.Header.Get(string(ContentType)) != "image/png" {
+				t.Errorf("unexpected upload headers %v", r.Header)
+			}
+			_, _ = io.Copy(uploaded, r.Body)
+			w.WriteHeader(http.StatusCreated)
+		case r.Method == http.MethodGet && r.URL.EscapedPath() == "/images/urn%3Ali%3Aimage%3AC4E10AQ":
+			status := MediaStatusAvailable
+			if processingPolls > 0 {
+				processingPolls--
+				status = MediaStatusProcessing
+			}
+			_, _ = w.Write([]byte(`{"id":"urn:li:image:C4E10AQ","status":"` + status + `"}`))
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	session := New("client", "secret").Session("token")
+	session.UseAuthorizationHeader()
+	session.BaseURL = server.URL
+	return session
+}
+
+// TestImagesUpload tests uploading an image and polling until it is available
+func TestImagesUpload(t *testing.T) {
+	uploaded := &bytes.Buffer{}
+	session := newImagesTestServer(t, 2, uploaded)
+
+	image := append(append([]byte{}, pngHeader...), bytes.Repeat([]byte{1}, 2048)...)
+	img, err := session.Images().Upload(context.Background(), UploadImageRequest{
+		Owner:        OrganizationURN(5515715),
+		Reader:       bytes.NewReader(image),
+		AltText:      "Logo",
+		PollInterval: time.Millisecond,
+	})
+	if err != nil {
+		t.Fatalf("Upload() error = %v", err)
+	}
+	if img.URN != ImageURN("C4E10AQ") {
+		t.Errorf("Upload() = %v; want urn:li:image:C4E10AQ", img.URN)
+	}
+	if !bytes.Equal(uploaded.Bytes(), image) {
+		t.Errorf("uploaded %d bytes; want %d", uploaded.Len(), len(image))
+	}
+	if media := img.Content().Media; media.ID != "urn:li:image:C4E10AQ" || media.AltText != "Logo" {
+		t.Errorf("Content().Media = %+v; want image with alt text", media)
+	}
+}
+
+// TestImagesUploadValidation tests rejecting unsupported and oversized images
+func TestImagesUploadValidation(t *testing.T) {
+	session := newImagesTestServer(t, 0, &bytes.Buffer{})
+
+	_, err := session.Images().Upload(context.Background(), UploadImageRequest{
+		Owner:  OrganizationURN(5515715),
+		Reader: strings.NewReader("plain text"),
+	})
+	if err == nil || !strings.Contains(err.Error(), "unsupported image content type") {
+		t.Errorf("Upload() error = %v; want unsupported content type", err)
+	}
+
+	image := append(append([]byte{}, pngHeader...), bytes.Repeat([]byte{1}, 4096)...)
+	_, err = session.Images().Upload(context.Background(), UploadImageRequest{
+		Owner:   OrganizationURN(5515715),
+		Reader:  bytes.NewReader(image),
+		MaxSize: 1024,
+	})
+	if err == nil || !strings.Contains(err.Error(), "exceeds the limit") {
+		t.Errorf("Upload() error = %v; want size limit error", err)
+	}
+
+	session.SetGrantedScopes(NewScopeSet(ScopeMemberSocialWrite))
+	_, err = session.Images().Upload(context.Background(), UploadImageRequest{
+		Owner:  OrganizationURN(5515715),
+		Reader: bytes.NewReader(image),
+	})
+	var scopeErr *ScopeError
+	if !errors.As(err, &scopeErr) {
+		t.Errorf("Upload() error = %v; want *ScopeError", err)
+	}
+}
+
+// TestSessionUploadUntrustedHost tests withholding the access token from foreign upload URLs
+func TestSessionUploadUntrustedHost(t *testing.T) {
+	foreign := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if auth := r.Header.Get(string(Authorization)); auth != "" {
+			t.Errorf("Authorization = %q; want none", auth)
+		}
+		w.WriteHeader(http.StatusCreated)
+	}))
+	defer foreign.Close()
+
+	session := newImagesTestServer(t, 0, &bytes.Buffer{})
+	if _, err := session.upload(context.Background(), foreign.URL+"/upload", "image/png", bytes.NewReader(pngHeader), 0); err != nil {
+		t.Fatalf("upload() error = %v; want nil", err)
+	}
+
+	tests := []struct {
+		uploadURL string
+		want      bool
+	}{
+		{"https://www.linkedin.com/dms-uploads/C5F10AQ", true},
+		{"https://api.linkedin.com/mediaUpload/C5F10AQ", true},
+		{"http://www.linkedin.com/dms-uploads/C5F10AQ", false},
+		{"https://www.linkedin.com.example.com/upload", false},
+		{"https://example.com/upload", false},
+	}
+	for _, tt := range tests {
+		u, _ := url.Parse(tt.uploadURL)
+		if got := session.trustedUploadURL(u); got != tt.want {
+			t.Errorf("trustedUploadURL(%s) = %v; want %v", tt.uploadURL, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,160 @@
+package linkedin
+
+import "context"
+
+// Collection struct for a page of a Rest.li collection response
+//
+// See: https://learn.microsoft.com/en-us/linkedin/shared/api-guide/concepts/pagination
+type Collection[T any] struct {
+	Paging   Paging             `json:"paging"`
+	Metadata CollectionMetadata `json:"metadata"`
+	Elements []T                `json:"elements"`
+}
+
+// IterateOptions controls how an Iterator fetches pages.
+type IterateOptions struct {
+	Count    int // page size sent as `count`, LinkedIn default if 0
+	MaxItems int // stop after this many elements, unlimited if 0
+}
+
+// Iterator iterates over the elements of a paginated Rest.li collection.
+//
+//	it := linkedin.Iterate[linkedin.ElementPost](ctx, session, "/posts", params, nil)
+//	for it.Next() {
+//		post := it.Value()
+//	}
+//	if err := it.Err(); err != nil {
+//		// handle error
+//	}
+type Iterator[T any] struct {
+	ctx     context.Context
+	session *Session
+	path    string
+	query   Params
+	opts    IterateOptions
+
+	page      *Collection[T]
+	index     int
+	yielded   int
+	nextPath  string // path of the next page
+	nextQuery Params // query of the next page
+	hasNext   bool
+	nextErr   error // error of an unresolvable next page link
+	started   bool
+	done      bool
+	err       error
+}
+
+// Iterate returns an iterator over the elements of the collection at path.
+//
+// The iterator follows the pagination returned by LinkedIn, see Collection.NextRequest.
+// Iteration stops when ctx is done.
+func Iterate[T any](ctx context.Context, session *Session, path string, query Params, opts *IterateOptions) *Iterator[T] {
+	if ctx == nil {
+		ctx = session.Context()
+	}
+
+	it := &Iterator[T]{
+		ctx:     ctx,
+		session: session,
+		path:    path,
+		query:   query.clone(),
+	}
+	if opts != nil {
+		it.opts = *opts
+	}
+	if it.opts.Count > 0 {
+		it.query["count"] = it.opts.Count
+	}
+	return it
+}
+
+// Next advances the iterator to the next element.
+// It returns false when the collection is exhausted or an error occurred.
+func (it *Iterator[T]) Next() bool {
+	if it.done {
+		return false
+	}
+	if it.opts.MaxItems > 0 && it.yielded >= it.opts.MaxItems {
+		it.done = true
+		return false
+	}
+
+	for it.page == nil || it.index >= len(it.page.Elements) {
+		if !it.fetch() {
+			it.done = true
+			return false
+		}
+	}
+
+	it.index++
+	it.yielded++
+	return true
+}
+
+// Value returns the current element.
+func (it *Iterator[T]) Value() T {
+	return it.page.Elements[it.index-1]
+}
+
+// Page returns the last fetched page.
+func (it *Iterator[T]) Page() *Collection[T] {
+	return it.page
+}
+
+// Err returns the error which stopped the iteration, if any.
+func (it *Iterator[T]) Err() error {
+	return it.err
+}
+
+// All collects the remaining elements.
+func (it *Iterator[T]) All() ([]T, error) {
+	var elements []T
+	for it.Next() {
+		elements = append(elements, it.Value())
+	}
+	return elements, it.Err()
+}
+
+// fetch requests the next page. It returns false if there is none.
+func (it *Iterator[T]) fetch() bool {
+	path, query, ok := it.nextRequest()
+	if !ok {
+		if it.nextErr != nil {
+			it.err = it.nextErr
+		}
+		return false
+	}
+	if err := it.ctx.Err(); err != nil {
+		it.err = err
+		return false
+	}
+
+	response, err := it.session.Do(it.ctx, Get, path, query, nil)
+	if err != nil {
+		it.err = err
+		return false
+	}
+
+	page := &Collection[T]{}
+	if err := response.Decode(page); err != nil {
+		it.err = err
+		return false
+	}
+
+	it.started = true
+	it.page = page
+	it.index = 0
+	it.nextPath, it.nextQuery, it.hasNext, it.nextErr = page.nextRequest(it.session.BaseURL, path, query)
+
+	// an empty page ends the collection
+	return len(page.Elements) > 0
+}
+
+// nextRequest returns the path and query of the next page.
+func (it *Iterator[T]) nextRequest() (string, Params, bool) {
+	if !it.started {
+		return it.path, it.query, true
+	}
+	return it.nextPath, it.nextQuery, it.hasNext
+}
@@ -0,0 +1,143 @@
+package linkedin
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+)
+
+// newIteratorTestSession returns a session pointing to a server which
+// serves a collection of 5 elements in pages of 2
+func newIteratorTestSession(t *testing.T, links bool) *Session {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		start, _ := strconv.Atoi(r.URL.Query().Get("start"))
+		end := start + 2
+		if end > 5 {
+			end = 5
+		}
+
+		elements := ""
+		for i := start; i < end; i++ {
+			if i > start {
+				elements += ","
+			}
+			elements += fmt.Sprintf(`{"id":"urn:li:share:%d"}`, i)
+		}
+
+		next := ""
+		if links && end < 5 {
+			next = fmt.Sprintf(`{"rel":"next","href":"/rest/posts?q=author&start=%d&count=2","type":"application/json"}`, end)
+		}
+		fmt.Fprintf(w, `{"paging":{"start":%d,"count":2,"total":5,"links":[%s]},"elements":[%s]}`, start, next, elements)
+	}))
+	t.Cleanup(server.Close)
+
+	session := New("id", "secret").Session("token")
+	session.BaseURL = server.URL
+	return session
+}
+
+// TestIterate tests following paging links and offsets
+func TestIterate(t *testing.T) {
+	for _, links := range []bool{true, false} {
+		session := newIteratorTestSession(t, links)
+		it := Iterate[ElementPost](context.Background(), session, "/posts", Params{"q": "author"}, &IterateOptions{Count: 2})
+
+		elements, err := it.All()
+		if err != nil || len(elements) != 5 {
+			t.Fatalf("links %v: All() = %d elements, %v; want 5, nil", links, len(elements), err)
+		}
+		if elements[4].ID != "urn:li:share:4" {
+			t.Errorf("links %v: last element = %s; want urn:li:share:4", links, elements[4].ID)
+		}
+	}
+}
+
+// TestIterateMaxItems tests stopping after MaxItems elements
+func TestIterateMaxItems(t *testing.T) {
+	session := newIteratorTestSession(t, true)
+	it := Iterate[ElementPost](context.Background(), session, "/posts", nil, &IterateOptions{MaxItems: 3})
+
+	elements, err := it.All()
+	if err != nil || len(elements) != 3 {
+		t.Errorf("All() = %d elements, %v; want 3, nil", len(elements), err)
+	}
+}
+
+// TestIterateCanceled tests stopping when the context is canceled
+func TestIterateCanceled(t *testing.T) {
+	session := newIteratorTestSession(t, true)
+	ctx, cancel := context.WithCancel(context.Background())
+	it := Iterate[ElementPost](ctx, session, "/posts", nil, nil)
+
+	if !it.Next() || !it.Next() {
+		t.Fatalf("Next() = false; want true for the first page, err %v", it.Err())
+	}
+	cancel()
+	if it.Next() || it.Err() != context.Canceled {
+		t.Errorf("Next() after cancel, Err() = %v; want context.Canceled", it.Err())
+	}
+}
+
+// TestIterateCursor tests following metadata.nextPageToken
+func TestIterateCursor(t *testing.T) {
+	tokens := map[string]string{"": "b", "b": "c", "c": ""}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		token := r.URL.Query().Get("pageToken")
+		fmt.Fprintf(w, `{"metadata":{"nextPageToken":%q},"elements":[{"id":"urn:li:share:%s"}]}`, tokens[token], token)
+	}))
+	defer server.Close()
+
+	session := New("id", "secret").Session("token")
+	session.BaseURL = server.URL
+	it := Iterate[ElementPost](context.Background(), session, "/posts", Params{"q": "author"}, nil)
+
+	elements, err := it.All()
+	if err != nil || len(elements) != 3 {
+		t.Fatalf("All() = %d elements, %v; want 3, nil", len(elements), err)
+	}
+	if elements[2].ID != "urn:li:share:c" {
+		t.Errorf("last element = %s; want urn:li:share:c", elements[2].ID)
+	}
+}
+
+// TestIterateUntrustedLink tests that a next link to a foreign host is not followed
+func TestIterateUntrustedLink(t *testing.T) {
+	var requests int
+	var authorization string
+	foreign := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		authorization = r.Header.Get(string(Authorization))
+		fmt.Fprint(w, `{}`)
+	}))
+	t.Cleanup(foreign.Close)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, `{"paging":{"start":0,"count":1,"links":[{"rel":"next","href":"%s/rest/posts?start=1"}]},"elements":[{"id":"urn:li:share:0"}]}`, foreign.URL)
+	}))
+	t.Cleanup(server.Close)
+
+	session := New("id", "secret").Session("token")
+	session.BaseURL = server.URL
+	session.UseAuthorizationHeader()
+
+	elements, err := Iterate[ElementPost](context.Background(), session, "/posts", nil, nil).All()
+	if len(elements) != 1 || !errors.Is(err, ErrUntrustedLink) {
+		t.Errorf("All() = %d elements, %v; want 1, ErrUntrustedLink", len(elements), err)
+	}
+	if requests != 0 {
+		t.Errorf("foreign host received %d requests; want 0", requests)
+	}
+
+	// absolute URLs passed to Do directly do not carry the access token
+	if _, err := session.Do(context.Background(), Get, foreign.URL+"/posts", nil, nil); err != nil {
+		t.Fatalf("Do() error = %v", err)
+	}
+	if authorization != "" {
+		t.Errorf("foreign host received Authorization %q; want none", authorization)
+	}
+}
@@ -0,0 +1,320 @@
+package linkedin
+
+import (
+	"context"
+	"crypto"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Sign In with LinkedIn using OpenID Connect endpoints
+//
+// See: https://learn.microsoft.com/en-us/linkedin/consumer/integrations/self-serve/sign-in-with-linkedin-v2
+const (
+	OpenIDIssuer = "https://www.linkedin.com/oauth"
+	JWKSURL      = "https://www.linkedin.com/oauth/openid/jwks"
+	UserInfoURL  = "https://api.linkedin.com/v2/userinfo"
+)
+
+// Errors returned by IDTokenVerifier.Verify
+var (
+	ErrInvalidIDToken = errors.New("linkedIn: invalid id token")
+	ErrIDTokenExpired = errors.New("linkedIn: id token expired")
+)
+
+// UserInfo - member profile returned by the OpenID Connect userinfo endpoint.
+type UserInfo struct {
+	Sub           string         `json:"sub"` // member ID
+	Name          string         `json:"name"`
+	GivenName     string         `json:"given_name"`
+	FamilyName    string         `json:"family_name"`
+	Picture       string         `json:"picture"`
+	Email         string         `json:"email"`
+	EmailVerified bool           `json:"email_verified"`
+	Locale        UserInfoLocale `json:"locale"`
+}
+
+// UserInfoLocale struct for the locale of a member
+type UserInfoLocale struct {
+	Country  string `json:"country"`  // e.g. US
+	Language string `json:"language"` // e.g. en
+}
+
+// UserInfo fetches the profile of the member who granted the `openid` and `profile` scopes.
+func (session *Session) UserInfo() (UserInfo, error) {
+	if err := session.RequireScopes(ScopeOpenID); err != nil {
+		return UserInfo{}, err
+	}
+
+	userInfoURL := UserInfoURL
+	if session.app != nil && session.app.UserInfoURL != "" {
+		userInfoURL = session.app.UserInfoURL
+	}
+
+	request, err := session.newRequest(session.Context(), GET, userInfoURL, nil)
+	if err != nil {
+		return UserInfo{}, err
+	}
+	accessToken, err := session.currentAccessToken(request.Context())
+	if err != nil {
+		return UserInfo{}, err
+	}
+	request.Header.Set(string(Authorization), "Bearer "+accessToken)
+
+	response, err := session.do(request)
+	if err != nil {
+		return UserInfo{}, err
+	}
+
+	var userInfo UserInfo
+	err = response.Decode(&userInfo)
+	return userInfo, err
+}
+
+// IDTokenClaims - claims of an ID token issued by LinkedIn.
+type IDTokenClaims struct {
+	Issuer        string   `json:"iss"`
+	Audience      audience `json:"aud"`
+	Subject       string   `json:"sub"` // member ID
+	IssuedAt      int64    `json:"iat"`
+	ExpiresAt     int64    `json:"exp"`
+	Nonce         string   `json:"nonce"`
+	Name          string   `json:"name"`
+	GivenName     string   `json:"given_name"`
+	FamilyName    string   `json:"family_name"`
+	Picture       string   `json:"picture"`
+	Email         string   `json:"email"`
+	EmailVerified jsonBool `json:"email_verified"`
+}
+
+// audience - `aud` claim which is either a string or a list of strings
+type audience []string
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (a *audience) UnmarshalJSON(data []byte) error {
+	var single string
+	if err := json.Unmarshal(data, &single); err == nil {
+		*a = audience{single}
+		return nil
+	}
+	var multiple []string
+	if err := json.Unmarshal(data, &multiple); err != nil {
+		return err
+	}
+	*a = multiple
+	return nil
+}
+
+// jsonBool - boolean claim which LinkedIn may encode as a string
+type jsonBool bool
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (b *jsonBool) UnmarshalJSON(data []byte) error {
+	*b = jsonBool(strings.Trim(string(data), `"`) == "true")
+	return nil
+}
+
+// IDTokenVerifier validates ID tokens issued by LinkedIn with the `openid` scope.
+//
+// Signing keys are fetched from JWKSURL and cached; they are fetched again
+// when a token is signed with an unknown key. It is safe for concurrent use.
+type IDTokenVerifier struct {
+	ClientID   string        // expected audience
+	Issuer     string        // expected issuer, OpenIDIssuer if empty
+	JWKSURL    string        // URL of the JSON Web Key Set, JWKSURL if empty
+	HTTPClient HTTPClient    // HTTP client to fetch keys, http.DefaultClient if nil
+	CacheTTL   time.Duration // how long keys are cached, 1 hour if 0
+	Leeway     time.Duration // allowed clock skew when checking expiry
+
+	mu        sync.Mutex
+	keys      map[string]*rsa.PublicKey
+	fetchedAt time.Time
+	now       func() time.Time
+}
+
+// IDTokenVerifier returns a verifier for ID tokens issued to the app.
+func (app *App) IDTokenVerifier() *IDTokenVerifier {
+	return &IDTokenVerifier{
+		ClientID:   app.ClientID,
+		HTTPClient: app.HTTPClient,
+		Leeway:     time.Minute,
+	}
+}
+
+// Verify checks the RS256 signature, issuer, audience and expiry of rawIDToken
+// and returns its claims. If nonce is not empty, the `nonce` claim must match it.
+func (v *IDTokenVerifier) Verify(ctx context.Context, rawIDToken, nonce string) (*IDTokenClaims, error) {
+	parts := strings.Split(rawIDToken, ".")
+	if len(parts) != 3 {
+		return nil, fmt.Errorf("%w: malformed token", ErrInvalidIDToken)
+	}
+
+	var header struct {
+		Alg string `json:"alg"`
+		Kid string `json:"kid"`
+	}
+	if err := decodeSegment(parts[0], &header); err != nil {
+		return nil, fmt.Errorf("%w: malformed header", ErrInvalidIDToken)
+	}
+	if header.Alg != "RS256" {
+		return nil, fmt.Errorf("%w: unsupported algorithm %s", ErrInvalidIDToken, header.Alg)
+	}
+
+	key, err := v.key(ctx, header.Kid)
+	if err != nil {
+		return nil, err
+	}
+	signature, err := base64.RawURLEncoding.DecodeString(parts[2])
+	if err != nil {
+		return nil, fmt.Errorf("%w: malformed signature", ErrInvalidIDToken)
+	}
+	digest := sha256.Sum256([]byte(parts[0] + "." + parts[1]))
+	if err := rsa.VerifyPKCS1v15(key, crypto.SHA256, digest[:], signature); err != nil {
+		return nil, fmt.Errorf("%w: invalid signature", ErrInvalidIDToken)
+	}
+
+	claims := &IDTokenClaims{}
+	if err := decodeSegment(parts[1], claims); err != nil {
+		return nil, fmt.Errorf("%w: malformed claims", ErrInvalidIDToken)
+	}
+
+	issuer := v.Issuer
+	if issuer == "" {
+		issuer = OpenIDIssuer
+	}
+	if claims.Issuer != issuer {
+		return nil, fmt.Errorf("%w: unexpected issuer %s", ErrInvalidIDToken, claims.Issuer)
+	}
+	if !claims.hasAudience(v.ClientID) {
+		return nil, fmt.Errorf("%w: unexpected audience %v", ErrInvalidIDToken, claims.Audience)
+	}
+	if !v.clock().Before(time.Unix(claims.ExpiresAt, 0).Add(v.Leeway)) {
+		return nil, ErrIDTokenExpired
+	}
+	if nonce != "" && claims.Nonce != nonce {
+		return nil, fmt.Errorf("%w: nonce mismatch", ErrInvalidIDToken)
+	}
+
+	return claims, nil
+}
+
+// hasAudience reports whether clientID is in the `aud` claim.
+func (c *IDTokenClaims) hasAudience(clientID string) bool {
+	for _, aud := range c.Audience {
+		if aud == clientID {
+			return true
+		}
+	}
+	return false
+}
+
+// key returns the signing key with the given ID, refreshing the cache
+// when it is stale or the key is unknown.
+func (v *IDTokenVerifier) key(ctx context.Context, kid string) (*rsa.PublicKey, error) {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+
+	ttl := v.CacheTTL
+	if ttl == 0 {
+		ttl = time.Hour
+	}
+	now := v.clock()
+	stale := now.Sub(v.fetchedAt) > ttl
+
+	if key, ok := v.keys[kid]; ok && !stale {
+		return key, nil
+	}
+	// limit refetches for unknown keys
+	if !stale && now.Sub(v.fetchedAt) < time.Minute {
+		return nil, fmt.Errorf("%w: unknown signing key %s", ErrInvalidIDToken, kid)
+	}
+
+	keys, err := v.fetchKeys(ctx)
+	if err != nil {
+		return nil, err
+	}
+	v.keys = keys
+	v.fetchedAt = now
+
+	key, ok := keys[kid]
+	if !ok {
+		return nil, fmt.Errorf("%w: unknown signing key %s", ErrInvalidIDToken, kid)
+	}
+	return key, nil
+}
+
+// fetchKeys downloads the JSON Web Key Set.
+func (v *IDTokenVerifier) fetchKeys(ctx context.Context) (map[string]*rsa.PublicKey, error) {
+	jwksURL := v.JWKSURL
+	if jwksURL == "" {
+		jwksURL = JWKSURL
+	}
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	request, err := http.NewRequestWithContext(ctx, string(GET), jwksURL, nil)
+	if err != nil {
+		return nil, fmt.Errorf("linkedIn: cannot create new request; %w", err)
+	}
+	session := &Session{HTTPClient: v.HTTPClient}
+	response, err := session.do(request)
+	if err != nil {
+		return nil, err
+	}
+
+	var jwks struct {
+		Keys []struct {
+			Kty string `json:"kty"`
+			Kid string `json:"kid"`
+			N   string `json:"n"`
+			E   string `json:"e"`
+		} `json:"keys"`
+	}
+	if err := response.Decode(&jwks); err != nil {
+		return nil, err
+	}
+
+	keys := make(map[string]*rsa.PublicKey, len(jwks.Keys))
+	for _, jwk := range jwks.Keys {
+		if jwk.Kty != "RSA" {
+			continue
+		}
+		n, errN := base64.RawURLEncoding.DecodeString(jwk.N)
+		e, errE := base64.RawURLEncoding.DecodeString(jwk.E)
+		if errN != nil || errE != nil || len(e) > 4 {
+			continue
+		}
+		keys[jwk.Kid] = &rsa.PublicKey{
+			N: new(big.Int).SetBytes(n),
+			E: int(new(big.Int).SetBytes(e).Int64()),
+		}
+	}
+	return keys, nil
+}
+
+// clock returns the current time.
+func (v *IDTokenVerifier) clock() time.Time {
+	if v.now != nil {
+		return v.now()
+	}
+	return time.Now()
+}
+
+// decodeSegment decodes a base64url encoded JSON segment of a JWT.
+func decodeSegment(segment string, v interface{}) error {
+	data, err := base64.RawURLEncoding.DecodeString(segment)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(data, v)
+}
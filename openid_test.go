@@ -0,0 +1,162 @@
+package linkedin
+
+import (
+	"context"
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+)
+
+// testKeySet serves a JSON Web Key Set of locally generated keys
+type testKeySet struct {
+	mu      sync.Mutex
+	keys    map[string]*rsa.PrivateKey
+	fetches int
+}
+
+// ServeHTTP implements http.Handler
+func (ks *testKeySet) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ks.mu.Lock()
+	defer ks.mu.Unlock()
+	ks.fetches++
+
+	var jwks struct {
+		Keys []map[string]string `json:"keys"`
+	}
+	for kid, key := range ks.keys {
+		jwks.Keys = append(jwks.Keys, map[string]string{
+			"kty": "RSA",
+			"kid": kid,
+			"use": "sig",
+			"alg": "RS256",
+			"n":   base64.RawURLEncoding.EncodeToString(key.N.Bytes()),
+			"e":   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(key.E)).Bytes()),
+		})
+	}
+	_ = json.NewEncoder(w).Encode(jwks)
+}
+
+// add generates a new signing key
+func (ks *testKeySet) add(t *testing.T, kid string) *rsa.PrivateKey {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatalf("rsa.GenerateKey() error = %v", err)
+	}
+	ks.mu.Lock()
+	ks.keys[kid] = key
+	ks.mu.Unlock()
+	return key
+}
+
+// signIDToken returns an RS256 signed JWT
+func signIDToken(t *testing.T, key *rsa.PrivateKey, kid string, claims map[string]interface{}) string {
+	header, _ := json.Marshal(map[string]string{"alg": "RS256", "typ": "JWT", "kid": kid})
+	payload, _ := json.Marshal(claims)
+	signingInput := base64.RawURLEncoding.EncodeToString(header) + "." + base64.RawURLEncoding.EncodeToString(payload)
+
+	digest := sha256.Sum256([]byte(signingInput))
+	signature, err := rsa.SignPKCS1v15(rand.Reader, key, crypto.SHA256, digest[:])
+	if err != nil {
+		t.Fatalf("rsa.SignPKCS1v15() error = %v", err)
+	}
+	return signingInput + "." + base64.RawURLEncoding.EncodeToString(signature)
+}
+
+// TestIDTokenVerifier tests ID token validation and key rotation
+func TestIDTokenVerifier(t *testing.T) {
+	ks := &testKeySet{keys: make(map[string]*rsa.PrivateKey)}
+	key := ks.add(t, "key-1")
+	server := httptest.NewServer(ks)
+	defer server.Close()
+
+	now := time.Now()
+	app := New("client", "secret")
+	verifier := app.IDTokenVerifier()
+	verifier.JWKSURL = server.URL
+	verifier.now = func() time.Time { return now }
+
+	claims := func(modify func(map[string]interface{})) map[string]interface{} {
+		c := map[string]interface{}{
+			"iss":            OpenIDIssuer,
+			"aud":            "client",
+			"sub":            "a1b2c3",
+			"iat":            now.Unix(),
+			"exp":            now.Add(time.Hour).Unix(),
+			"nonce":          "nonce",
+			"email":          "member@example.com",
+			"email_verified": "true",
+		}
+		if modify != nil {
+			modify(c)
+		}
+		return c
+	}
+	ctx := context.Background()
+
+	got, err := verifier.Verify(ctx, signIDToken(t, key, "key-1", claims(nil)), "nonce")
+	if err != nil || got.Subject != "a1b2c3" || !bool(got.EmailVerified) {
+		t.Fatalf("Verify() = %+v, %v; want subject a1b2c3", got, err)
+	}
+
+	tests := []struct {
+		name  string
+		token string
+		nonce string
+		want  error
+	}{
+		{"audience", signIDToken(t, key, "key-1", claims(func(c map[string]interface{}) { c["aud"] = "other" })), "", ErrInvalidIDToken},
+		{"issuer", signIDToken(t, key, "key-1", claims(func(c map[string]interface{}) { c["iss"] = "https://example.com" })), "", ErrInvalidIDToken},
+		{"expired", signIDToken(t, key, "key-1", claims(func(c map[string]interface{}) { c["exp"] = now.Add(-time.Hour).Unix() })), "", ErrIDTokenExpired},
+		{"nonce", signIDToken(t, key, "key-1", claims(nil)), "other", ErrInvalidIDToken},
+		{"signature", signIDToken(t, key, "key-1", claims(nil)) + "x", "", ErrInvalidIDToken},
+		{"malformed", "a.b", "", ErrInvalidIDToken},
+	}
+	for _, test := range tests {
+		if _, err := verifier.Verify(ctx, test.token, test.nonce); !errors.Is(err, test.want) {
+			t.Errorf("%s: Verify() error = %v; want %v", test.name, err, test.want)
+		}
+	}
+	if ks.fetches != 1 {
+		t.Errorf("JWKS fetches = %d; want 1 (cached)", ks.fetches)
+	}
+
+	// key rotation
+	rotated := ks.add(t, "key-2")
+	now = now.Add(2 * time.Minute)
+	if _, err := verifier.Verify(ctx, signIDToken(t, rotated, "key-2", claims(nil)), ""); err != nil {
+		t.Errorf("Verify() with rotated key error = %v; want nil", err)
+	}
+	if ks.fetches != 2 {
+		t.Errorf("JWKS fetches = %d; want 2", ks.fetches)
+	}
+}
+
+// TestUserInfo tests fetching the OpenID Connect userinfo
+func TestUserInfo(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer token" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		fmt.Fprint(w, `{"sub":"a1b2c3","name":"Jane Doe","email":"jane@example.com","email_verified":true,"locale":{"country":"US","language":"en"}}`)
+	}))
+	defer server.Close()
+
+	app := New("client", "secret")
+	app.UserInfoURL = server.URL
+	userInfo, err := app.Session("token").UserInfo()
+	if err != nil || userInfo.Sub != "a1b2c3" || !userInfo.EmailVerified || userInfo.Locale.Country != "US" {
+		t.Errorf("UserInfo() = %+v, %v", userInfo, err)
+	}
+}
@@ -3,10 +3,7 @@ package linkedin
 import "strings"
 
 // Organization struct for LinkedIn organizations
-type Organization struct {
-	Paging   Paging                `json:"paging"`
-	Elements []ElementOrganization `json:"elements"`
-}
+type Organization = Collection[ElementOrganization]
 
 // ElementOrganization struct for LinkedIn organization elements
 type ElementOrganization struct {
@@ -35,9 +32,22 @@ type Created struct {
 // GetOrganizationID returns the organization ID from the organization URN
 func (e *ElementOrganization) GetOrganizationID() string {
 	// extract the organization ID from e.g. urn:li:organization:123456789
+	if urn, err := ParseURN(e.Organization); err == nil {
+		return urn.ID
+	}
 	return e.Organization[strings.LastIndex(e.Organization, ":")+1:]
 }
 
+// GetOrganizationURN parses the organization URN
+func (e *ElementOrganization) GetOrganizationURN() (URN, error) {
+	return ParseURN(e.Organization)
+}
+
+// GetRoleAssigneeURN parses the URN of the member assigned to the role
+func (e *ElementOrganization) GetRoleAssigneeURN() (URN, error) {
+	return ParseURN(e.RoleAssignee)
+}
+
 // OrganizationInfo struct for LinkedIn organization information
 type OrganizationInfo struct {
 	VanityName              string        `json:"vanityName"`
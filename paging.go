@@ -1,9 +1,16 @@
 package linkedin
 
 import (
+	"errors"
+	"fmt"
+	"net/url"
 	"strings"
 )
 
+// ErrUntrustedLink is returned for pagination links pointing to a host
+// other than the session base URL or VersionedBaseURL.
+var ErrUntrustedLink = errors.New("linkedIn: untrusted pagination link")
+
 // Paging struct for pagination
 type Paging struct {
 	Start int    `json:"start"`
@@ -19,24 +26,226 @@ type Link struct {
 	Href string `json:"href"`
 }
 
-// GetNext returns the next page URL
+// GetNext returns the next page URL relative to VersionedBaseURL,
+// or the absolute URL if the link points elsewhere.
+//
+// Use NextURL to resolve the link against a custom Session.BaseURL.
 func (p *Paging) GetNext() (bool, string) {
-	for _, link := range p.Links {
-		if link.Rel == "next" {
-			// remove "/rest" from the URL
-			return true, strings.Replace(link.Href, "/rest", "", 1)
-		}
+	link, ok := p.link("next")
+	if !ok {
+		return false, ""
 	}
-	return false, ""
+	return true, link.relative()
 }
 
-// GetPrev returns the previous page URL
+// GetPrev returns the previous page URL relative to VersionedBaseURL,
+// or the absolute URL if the link points elsewhere.
+//
+// Use PrevURL to resolve the link against a custom Session.BaseURL.
 func (p *Paging) GetPrev() (bool, string) {
+	link, ok := p.link("prev")
+	if !ok {
+		return false, ""
+	}
+	return true, link.relative()
+}
+
+// NextURL resolves the next page link, see Link.Resolve.
+// It returns nil if there is no next page.
+func (p *Paging) NextURL(baseURL string, requestURL *url.URL) (*url.URL, error) {
+	link, ok := p.link("next")
+	if !ok {
+		return nil, nil
+	}
+	return link.Resolve(baseURL, requestURL)
+}
+
+// PrevURL resolves the previous page link, see Link.Resolve.
+// It returns nil if there is no previous page.
+func (p *Paging) PrevURL(baseURL string, requestURL *url.URL) (*url.URL, error) {
+	link, ok := p.link("prev")
+	if !ok {
+		return nil, nil
+	}
+	return link.Resolve(baseURL, requestURL)
+}
+
+// link returns the first link with the given relation.
+func (p *Paging) link(rel string) (Link, bool) {
 	for _, link := range p.Links {
-		if link.Rel == "prev" {
-			// remove "/rest" from the URL
-			return true, strings.Replace(link.Href, "/rest", "", 1)
+		if link.Rel == rel {
+			return link, true
+		}
+	}
+	return Link{}, false
+}
+
+// Resolve resolves the link against baseURL, the base URL of the session,
+// and returns an absolute URL.
+//
+// LinkedIn returns links such as /rest/posts?q=author&start=10&count=10.
+// The path prefix of baseURL, or else of VersionedBaseURL, is removed from
+// the link before it is appended to baseURL. Absolute links are returned unchanged
+// if they point to the host of baseURL or VersionedBaseURL, and rejected with
+// ErrUntrustedLink otherwise, so a response cannot send the access token elsewhere.
+// Query-only and relative links are resolved against requestURL,
+// the URL of the request which returned the link, or baseURL if it is nil.
+func (l Link) Resolve(baseURL string, requestURL *url.URL) (*url.URL, error) {
+	base, err := url.Parse(baseURL)
+	if err != nil || !base.IsAbs() {
+		return nil, fmt.Errorf("linkedIn: invalid base URL %q", baseURL)
+	}
+	href, err := url.Parse(strings.TrimSpace(l.Href))
+	if err != nil {
+		return nil, fmt.Errorf("linkedIn: invalid link %q; %w", l.Href, err)
+	}
+
+	if href.IsAbs() || href.Host != "" {
+		if href.Scheme == "" {
+			href.Scheme = base.Scheme
+		}
+		if !sameOrigin(href, base) && !sameOrigin(href, versionedBaseURL()) {
+			return nil, fmt.Errorf("%w: %s", ErrUntrustedLink, href.Redacted())
+		}
+		return href, nil
+	}
+	if requestURL == nil {
+		requestURL = base
+	}
+	if href.Path == "" {
+		u := *requestURL
+		u.RawQuery = href.RawQuery
+		u.Fragment = ""
+		return &u, nil
+	}
+	if !strings.HasPrefix(href.Path, "/") {
+		return requestURL.ResolveReference(href), nil
+	}
+
+	basePath := strings.TrimSuffix(base.EscapedPath(), "/")
+	path, ok := trimPathPrefix(href.EscapedPath(), basePath)
+	if !ok {
+		path, _ = trimPathPrefix(path, versionedBasePath())
+	}
+
+	u, err := url.Parse(base.Scheme + "://" + base.Host + basePath + path)
+	if err != nil {
+		return nil, fmt.Errorf("linkedIn: invalid link %q; %w", l.Href, err)
+	}
+	u.RawQuery = href.RawQuery
+	return u, nil
+}
+
+// relative resolves the link against VersionedBaseURL and returns it
+// relative to VersionedBaseURL, or the absolute URL if it points elsewhere.
+func (l Link) relative() string {
+	u, err := l.Resolve(VersionedBaseURL, nil)
+	if err != nil {
+		return l.Href
+	}
+
+	base, _ := url.Parse(VersionedBaseURL)
+	if u.Scheme != base.Scheme || u.Host != base.Host {
+		return u.String()
+	}
+	path, ok := trimPathPrefix(u.EscapedPath(), versionedBasePath())
+	if !ok {
+		return u.String()
+	}
+	if u.RawQuery != "" {
+		path += "?" + u.RawQuery
+	}
+	return path
+}
+
+// versionedBasePath returns the path of VersionedBaseURL, i.e. /rest
+func versionedBasePath() string {
+	return strings.TrimSuffix(versionedBaseURL().EscapedPath(), "/")
+}
+
+// versionedBaseURL returns the parsed VersionedBaseURL.
+func versionedBaseURL() *url.URL {
+	base, err := url.Parse(VersionedBaseURL)
+	if err != nil {
+		return &url.URL{}
+	}
+	return base
+}
+
+// sameOrigin reports whether u and base have the same scheme and host.
+func sameOrigin(u, base *url.URL) bool {
+	return base != nil && strings.EqualFold(u.Scheme, base.Scheme) && strings.EqualFold(u.Host, base.Host)
+}
+
+// trimPathPrefix removes prefix from path if it is a whole path segment prefix,
+// e.g. /rest from /rest/posts but not from /restrictedEntities.
+func trimPathPrefix(path, prefix string) (string, bool) {
+	if prefix == "" {
+		return path, false
+	}
+	if path == prefix {
+		return "/", true
+	}
+	if strings.HasPrefix(path, prefix+"/") {
+		return path[len(prefix):], true
+	}
+	return path, false
+}
+
+// CollectionMetadata struct for cursor-based pagination
+//
+// See: https://learn.microsoft.com/en-us/linkedin/shared/api-guide/concepts/pagination#cursor-based-pagination
+type CollectionMetadata struct {
+	NextPageToken string `json:"nextPageToken"`
+}
+
+// NextRequest returns the path and query to request the page after c,
+// given the session base URL and the path and query of the request which returned c.
+//
+// Cursor-based pagination (`metadata.nextPageToken`) takes precedence over
+// the `next` link of `paging`, which takes precedence over `start`/`count` offsets.
+// Links are resolved with Link.Resolve and returned as absolute URLs.
+// It returns false if c is the last page.
+func (c *Collection[T]) NextRequest(baseURL, path string, query Params) (string, Params, bool) {
+	next, nextQuery, ok, _ := c.nextRequest(baseURL, path, query)
+	return next, nextQuery, ok
+}
+
+// nextRequest is NextRequest which also returns the error of an unresolvable `next` link.
+func (c *Collection[T]) nextRequest(baseURL, path string, query Params) (string, Params, bool, error) {
+	if len(c.Elements) == 0 {
+		return "", nil, false, nil
+	}
+
+	// cursor-based pagination
+	if token := c.Metadata.NextPageToken; token != "" {
+		if query["pageToken"] == token {
+			return "", nil, false, nil
+		}
+		next := query.clone()
+		next["pageToken"] = token
+		return path, next, true, nil
+	}
+
+	// links of offset-based pagination
+	if link, ok := c.Paging.link("next"); ok {
+		requestURL, err := url.Parse(joinURL(baseURL, path))
+		if err != nil {
+			return "", nil, false, nil
 		}
+		next, err := link.Resolve(baseURL, requestURL)
+		if err != nil {
+			return "", nil, false, err
+		}
+		return next.String(), nil, true, nil
+	}
+
+	// offsets
+	start := c.Paging.Start + len(c.Elements)
+	if c.Paging.Total <= 0 || start >= c.Paging.Total {
+		return "", nil, false, nil
 	}
-	return false, ""
+	next := query.clone()
+	next["start"] = start
+	return path, next, true, nil
 }
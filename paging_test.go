@@ -0,0 +1,92 @@
+package linkedin
+
+import (
+	"errors"
+	"net/url"
+	"testing"
+)
+
+// TestLinkResolve tests resolving pagination links against the session base URL
+func TestLinkResolve(t *testing.T) {
+	requestURL, _ := url.Parse("https://api.linkedin.com/rest/posts?q=author&start=0&count=10")
+	proxyRequestURL, _ := url.Parse("http://127.0.0.1:8080/linkedin/rest/posts?q=author")
+
+	tests := []struct {
+		name       string
+		baseURL    string
+		requestURL *url.URL
+		href       string
+		want       string
+	}{
+		{"versioned", VersionedBaseURL, nil, "/rest/posts?q=author&start=10&count=10", "https://api.linkedin.com/rest/posts?q=author&start=10&count=10"},
+		{"without prefix", VersionedBaseURL, nil, "/posts?start=10", "https://api.linkedin.com/rest/posts?start=10"},
+		{"rest in path", VersionedBaseURL, nil, "/rest/restrictedEntities?start=10", "https://api.linkedin.com/rest/restrictedEntities?start=10"},
+		{"rest prefix only", VersionedBaseURL, nil, "/restrictedEntities?start=10", "https://api.linkedin.com/rest/restrictedEntities?start=10"},
+		{"encoded path", VersionedBaseURL, nil, "/rest/posts/urn%3Ali%3Ashare%3A1?start=1", "https://api.linkedin.com/rest/posts/urn%3Ali%3Ashare%3A1?start=1"},
+		{"test server", "http://127.0.0.1:8080", nil, "/rest/posts?start=10", "http://127.0.0.1:8080/posts?start=10"},
+		{"proxy", "http://127.0.0.1:8080/linkedin/rest", nil, "/rest/posts?start=10", "http://127.0.0.1:8080/linkedin/rest/posts?start=10"},
+		{"proxy rewritten", "http://127.0.0.1:8080/linkedin/rest", nil, "/linkedin/rest/posts?start=10", "http://127.0.0.1:8080/linkedin/rest/posts?start=10"},
+		{"absolute", "http://127.0.0.1:8080", nil, "https://api.linkedin.com/rest/posts?start=10", "https://api.linkedin.com/rest/posts?start=10"},
+		{"query only", VersionedBaseURL, requestURL, "?q=author&start=10&count=10", "https://api.linkedin.com/rest/posts?q=author&start=10&count=10"},
+		{"query only proxy", "http://127.0.0.1:8080/linkedin/rest", proxyRequestURL, "?q=author&start=10", "http://127.0.0.1:8080/linkedin/rest/posts?q=author&start=10"},
+		{"relative", VersionedBaseURL, requestURL, "posts?start=10", "https://api.linkedin.com/rest/posts?start=10"},
+	}
+
+	for _, test := range tests {
+		u, err := Link{Rel: "next", Href: test.href}.Resolve(test.baseURL, test.requestURL)
+		if err != nil || u.String() != test.want {
+			t.Errorf("%s: Resolve(%s) = %v, %v; want %s", test.name, test.href, u, err, test.want)
+		}
+	}
+
+	untrusted := []struct {
+		name    string
+		baseURL string
+		href    string
+	}{
+		{"foreign host", VersionedBaseURL, "https://attacker.example/rest/posts?start=10"},
+		{"foreign scheme", VersionedBaseURL, "http://api.linkedin.com/rest/posts?start=10"},
+		{"foreign port", "http://127.0.0.1:8080", "http://127.0.0.1:9090/posts?start=10"},
+		{"protocol relative", VersionedBaseURL, "//attacker.example/rest/posts?start=10"},
+	}
+
+	for _, test := range untrusted {
+		u, err := Link{Rel: "next", Href: test.href}.Resolve(test.baseURL, nil)
+		if !errors.Is(err, ErrUntrustedLink) {
+			t.Errorf("%s: Resolve(%s) = %v, %v; want ErrUntrustedLink", test.name, test.href, u, err)
+		}
+	}
+
+	if _, err := (Link{Href: "/rest/posts"}).Resolve("/rest", nil); err == nil {
+		t.Errorf("Resolve() with relative base URL error = nil; want error")
+	}
+}
+
+// TestPagingGetNext tests the next page URL relative to VersionedBaseURL
+func TestPagingGetNext(t *testing.T) {
+	tests := []struct {
+		href string
+		want string
+	}{
+		{"/rest/posts?q=author&start=10", "/posts?q=author&start=10"},
+		{"/rest/restrictedEntities?start=10", "/restrictedEntities?start=10"},
+		{"https://api.linkedin.com/rest/organizationAcls?start=10", "/organizationAcls?start=10"},
+		{"http://127.0.0.1:8080/rest/posts?start=10", "http://127.0.0.1:8080/rest/posts?start=10"},
+	}
+
+	for _, test := range tests {
+		paging := Paging{Links: []Link{{Rel: "prev", Href: "/rest/posts?start=0"}, {Rel: "next", Href: test.href}}}
+		ok, next := paging.GetNext()
+		if !ok || next != test.want {
+			t.Errorf("GetNext() for %s = %v, %s; want true, %s", test.href, ok, next, test.want)
+		}
+	}
+
+	paging := Paging{}
+	if ok, next := paging.GetNext(); ok || next != "" {
+		t.Errorf("GetNext() without links = %v, %s; want false, empty", ok, next)
+	}
+	if u, err := paging.NextURL(VersionedBaseURL, nil); u != nil || err != nil {
+		t.Errorf("NextURL() without links = %v, %v; want nil, nil", u, err)
+	}
+}
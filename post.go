@@ -1,10 +1,7 @@
 package linkedin
 
 // Post struct for LinkedIn posts
-type Post struct {
-	Paging   Paging        `json:"paging"`
-	Elements []ElementPost `json:"elements"`
-}
+type Post = Collection[ElementPost]
 
 // ElementPost struct for LinkedIn post elements
 type ElementPost struct {
@@ -19,18 +16,108 @@ type ElementPost struct {
 	ReshareContext            ReshareContextPost     `json:"reshareContext"`
 	Distribution              DistributionPost       `json:"distribution"`
 	Commentary                string                 `json:"commentary"` // e.g. Hello, world!
+	Content                   *ContentPost           `json:"content,omitempty"`
+	ContentCallToActionLabel  string                 `json:"contentCallToActionLabel,omitempty"` // e.g. LEARN_MORE
+	ContentLandingPage        string                 `json:"contentLandingPage,omitempty"`
 	LifecycleStateInfo        LifecycleStateInfoPost `json:"lifecycleStateInfo"`
 }
 
+// GetURN parses the URN of the post
+func (e *ElementPost) GetURN() (URN, error) {
+	return ParseURN(e.ID)
+}
+
+// GetAuthorURN parses the URN of the post author
+func (e *ElementPost) GetAuthorURN() (URN, error) {
+	return ParseURN(e.Author)
+}
+
 // ReshareContextPost struct for LinkedIn post reshare context
 type ReshareContextPost struct {
-	Parent string `json:"parent"` // e.g. urn:li:ugcPost:123456
-	Root   string `json:"root"`   // e.g. urn:li:ugcPost:123456
+	Parent string `json:"parent"`         // e.g. urn:li:ugcPost:123456
+	Root   string `json:"root,omitempty"` // e.g. urn:li:ugcPost:123456, set by LinkedIn
 }
 
 // DistributionPost struct for LinkedIn post distribution
 type DistributionPost struct {
-	FeedDistribution string `json:"feedDistribution"` // e.g. MAIN_FEED
+	FeedDistribution               string   `json:"feedDistribution"` // e.g. MAIN_FEED
+	TargetEntities                 []Result `json:"targetEntities,omitempty"`
+	ThirdPartyDistributionChannels []string `json:"thirdPartyDistributionChannels,omitempty"`
+}
+
+// ContentPost struct for LinkedIn post content, at most one field is set
+type ContentPost struct {
+	Media       *MediaContentPost       `json:"media,omitempty"`
+	Article     *ArticleContentPost     `json:"article,omitempty"`
+	MultiImage  *MultiImageContentPost  `json:"multiImage,omitempty"`
+	Poll        *PollContentPost        `json:"poll,omitempty"`
+	Carousel    *CarouselContentPost    `json:"carousel,omitempty"`
+	Celebration *CelebrationContentPost `json:"celebration,omitempty"`
+}
+
+// MediaContentPost struct for LinkedIn post image, video or document content
+type MediaContentPost struct {
+	ID      string `json:"id"` // e.g. urn:li:image:C4E10AQ, urn:li:video:C5F10AQ
+	Title   string `json:"title,omitempty"`
+	AltText string `json:"altText,omitempty"`
+}
+
+// MultiImageContentPost struct for LinkedIn post multi-image content
+type MultiImageContentPost struct {
+	Images []ImageMultiImageContentPost `json:"images"`
+}
+
+// ImageMultiImageContentPost struct for an image of LinkedIn post multi-image content
+type ImageMultiImageContentPost struct {
+	ID      string `json:"id"` // e.g. urn:li:image:C4E10AQ
+	AltText string `json:"altText,omitempty"`
+}
+
+// PollContentPost struct for LinkedIn post poll content
+type PollContentPost struct {
+	Question string                  `json:"question"`
+	Options  []OptionPollContentPost `json:"options"`
+	Settings SettingsPollContentPost `json:"settings"`
+}
+
+// OptionPollContentPost struct for an option of LinkedIn post poll content
+type OptionPollContentPost struct {
+	Text      string `json:"text"`
+	VoteCount int64  `json:"voteCount,omitempty"` // set by LinkedIn
+}
+
+// SettingsPollContentPost struct for the settings of LinkedIn post poll content
+type SettingsPollContentPost struct {
+	Duration               string `json:"duration"`                    // e.g. THREE_DAYS
+	VoteSelectionType      string `json:"voteSelectionType,omitempty"` // e.g. SINGLE_VOTE
+	IsVoterVisibleToAuthor bool   `json:"isVoterVisibleToAuthor,omitempty"`
+}
+
+// CarouselContentPost struct for LinkedIn post carousel content
+type CarouselContentPost struct {
+	Cards []CardCarouselContentPost `json:"cards"`
+}
+
+// CardCarouselContentPost struct for a card of LinkedIn post carousel content
+type CardCarouselContentPost struct {
+	Media       MediaContentPost `json:"media"`
+	LandingPage string           `json:"landingPage"` // e.g. https://example.com
+}
+
+// CelebrationContentPost struct for LinkedIn post celebration content
+type CelebrationContentPost struct {
+	Type      string            `json:"type"` // e.g. CELEBRATE_WELCOME
+	Text      string            `json:"text,omitempty"`
+	Recipient []string          `json:"recipient,omitempty"` // e.g. urn:li:person:abc123
+	Media     *MediaContentPost `json:"media,omitempty"`
+}
+
+// ArticleContentPost struct for LinkedIn post article content
+type ArticleContentPost struct {
+	Source      string `json:"source"` // e.g. https://example.com/article
+	Title       string `json:"title,omitempty"`
+	Description string `json:"description,omitempty"`
+	Thumbnail   string `json:"thumbnail,omitempty"` // e.g. urn:li:image:C4E10AQ
 }
 
 // LifecycleStateInfoPost struct for LinkedIn post lifecycle state information
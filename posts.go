@@ -0,0 +1,231 @@
+package linkedin
+
+import (
+	"context"
+	"fmt"
+)
+
+// Post visibility
+const (
+	VisibilityPublic      = "PUBLIC"
+	VisibilityConnections = "CONNECTIONS"
+	VisibilityLoggedIn    = "LOGGED_IN"
+	VisibilityContainer   = "CONTAINER"
+)
+
+// Post lifecycle states
+const (
+	LifecycleStatePublished = "PUBLISHED"
+	LifecycleStateDraft     = "DRAFT"
+)
+
+// Post feed distribution
+const (
+	FeedDistributionMainFeed = "MAIN_FEED"
+	FeedDistributionNone     = "NONE"
+)
+
+// Post finder sort orders
+const (
+	SortByLastModified = "LAST_MODIFIED"
+	SortByCreated      = "CREATED"
+)
+
+// Post finder view contexts
+const (
+	ViewContextReader = "READER" // only published posts
+	ViewContextAuthor = "AUTHOR" // also drafts, requires the author's permissions
+)
+
+// PostsService - LinkedIn Posts API
+//
+// See: https://learn.microsoft.com/en-us/linkedin/marketing/community-management/shares/posts-api
+type PostsService struct {
+	session *Session
+}
+
+// Posts returns the Posts API service of the session.
+func (session *Session) Posts() *PostsService {
+	return &PostsService{session: session}
+}
+
+// CreatePostRequest struct for creating a LinkedIn post
+//
+// The fields are named after ElementPost.
+type CreatePostRequest struct {
+	Author                    string              `json:"author"` // e.g. urn:li:organization:123456
+	Commentary                string              `json:"commentary"`
+	Visibility                string              `json:"visibility"`     // PUBLIC by default
+	Distribution              DistributionPost    `json:"distribution"`   // MAIN_FEED by default
+	LifecycleState            string              `json:"lifecycleState"` // PUBLISHED by default
+	IsReshareDisabledByAuthor bool                `json:"isReshareDisabledByAuthor"`
+	Content                   *ContentPost        `json:"content,omitempty"`
+	ReshareContext            *ReshareContextPost `json:"reshareContext,omitempty"`
+}
+
+// PostWriteScopes returns the scope required to create or edit posts of author:
+// w_organization_social for organizations and w_member_social for members.
+func PostWriteScopes(author URN) []Scope {
+	if author.EntityType == EntityOrganization {
+		return []Scope{ScopeOrganizationSocialWrite}
+	}
+	return []Scope{ScopeMemberSocialWrite}
+}
+
+// Create creates a post and returns its URN, e.g. urn:li:share:123456
+func (s *PostsService) Create(ctx context.Context, post CreatePostRequest) (URN, error) {
+	author, err := ParseURN(post.Author)
+	if err != nil {
+		return URN{}, fmt.Errorf("linkedIn: post author is required; %w", err)
+	}
+	if err := s.session.RequireScopes(PostWriteScopes(author)...); err != nil {
+		return URN{}, err
+	}
+	if post.Content != nil && post.Content.Article != nil && post.Content.Article.Source == "" {
+		return URN{}, fmt.Errorf("linkedIn: article source is required")
+	}
+	if post.ReshareContext != nil && post.ReshareContext.Parent == "" {
+		return URN{}, fmt.Errorf("linkedIn: parent post is required to reshare")
+	}
+
+	// defaults
+	if post.Visibility == "" {
+		post.Visibility = VisibilityPublic
+	}
+	if post.LifecycleState == "" {
+		post.LifecycleState = LifecycleStatePublished
+	}
+	if post.Distribution.FeedDistribution == "" {
+		post.Distribution.FeedDistribution = FeedDistributionMainFeed
+	}
+
+	response, err := s.session.Do(ctx, Create, "/posts", nil, post)
+	if err != nil {
+		return URN{}, err
+	}
+	return ParseURN(response.CreatedEntityID())
+}
+
+// CreateText creates a public text post.
+func (s *PostsService) CreateText(ctx context.Context, author URN, commentary string) (URN, error) {
+	return s.Create(ctx, CreatePostRequest{
+		Author:     author.String(),
+		Commentary: commentary,
+	})
+}
+
+// CreateArticle creates a public post sharing an article.
+func (s *PostsService) CreateArticle(ctx context.Context, author URN, commentary string, article ArticleContentPost) (URN, error) {
+	return s.Create(ctx, CreatePostRequest{
+		Author:     author.String(),
+		Commentary: commentary,
+		Content:    &ContentPost{Article: &article},
+	})
+}
+
+// Reshare creates a public post resharing parent, with an optional commentary.
+func (s *PostsService) Reshare(ctx context.Context, author, parent URN, commentary string) (URN, error) {
+	return s.Create(ctx, CreatePostRequest{
+		Author:         author.String(),
+		Commentary:     commentary,
+		ReshareContext: &ReshareContextPost{Parent: parent.String()},
+	})
+}
+
+// Get returns the post with the given URN, e.g. urn:li:share:123456 or urn:li:ugcPost:123456
+func (s *PostsService) Get(ctx context.Context, urn URN) (ElementPost, error) {
+	response, err := s.session.Do(ctx, Get, "/posts/"+urn.RestLiString(), nil, nil)
+	if err != nil {
+		return ElementPost{}, err
+	}
+
+	var post ElementPost
+	err = response.Decode(&post)
+	return post, err
+}
+
+// BatchGet returns the posts with the given URNs.
+// Posts which cannot be retrieved are reported in the Errors of the result.
+func (s *PostsService) BatchGet(ctx context.Context, urns []URN) (BatchResult[URN, ElementPost], error) {
+	if len(urns) == 0 {
+		return BatchResult[URN, ElementPost]{}, fmt.Errorf("linkedIn: at least one post URN is required")
+	}
+
+	response, err := s.session.Do(ctx, BatchGet, "/posts", Params{"ids": urns}, nil)
+	if err != nil {
+		return BatchResult[URN, ElementPost]{}, err
+	}
+
+	var result BatchResult[URN, ElementPost]
+	if err := response.Decode(&result); err != nil {
+		return BatchResult[URN, ElementPost]{}, err
+	}
+	result.setErrorStatus()
+	return result, nil
+}
+
+// PostPatch struct for editing a LinkedIn post, only non-nil fields are changed
+type PostPatch struct {
+	Commentary               *string `json:"commentary,omitempty"`
+	ContentCallToActionLabel *string `json:"contentCallToActionLabel,omitempty"` // e.g. LEARN_MORE
+	ContentLandingPage       *string `json:"contentLandingPage,omitempty"`       // e.g. https://example.com
+	LifecycleState           *string `json:"lifecycleState,omitempty"`           // e.g. PUBLISHED
+}
+
+// Update edits the post with the given URN with a PARTIAL_UPDATE request.
+func (s *PostsService) Update(ctx context.Context, urn URN, patch PostPatch) error {
+	body := Params{
+		"patch": Params{
+			"$set": patch,
+		},
+	}
+
+	_, err := s.session.Do(ctx, PartialUpdate, "/posts/"+urn.RestLiString(), nil, body)
+	return err
+}
+
+// Delete deletes the post with the given URN.
+func (s *PostsService) Delete(ctx context.Context, urn URN) error {
+	_, err := s.session.Do(ctx, Delete, "/posts/"+urn.RestLiString(), nil, nil)
+	return err
+}
+
+// FindPostsOptions struct for filtering posts of an author
+type FindPostsOptions struct {
+	SortBy      string // e.g. LAST_MODIFIED, LinkedIn default if empty
+	IsDsc       *bool  // descending order, LinkedIn default if nil
+	ViewContext string // e.g. READER, LinkedIn default if empty
+	Count       int    // page size sent as `count`, LinkedIn default if 0
+	MaxItems    int    // stop after this many posts, unlimited if 0
+}
+
+// FindByAuthor iterates over the posts of the given person or organization.
+//
+//	it := session.Posts().FindByAuthor(ctx, linkedin.OrganizationURN(123456), &linkedin.FindPostsOptions{Count: 100})
+//	for it.Next() {
+//		post := it.Value()
+//	}
+//
+// See: https://learn.microsoft.com/en-us/linkedin/marketing/community-management/shares/posts-api#find-posts-by-authors
+func (s *PostsService) FindByAuthor(ctx context.Context, author URN, opts *FindPostsOptions) *Iterator[ElementPost] {
+	query := Params{
+		"q":      "author",
+		"author": author,
+	}
+
+	var iterateOpts IterateOptions
+	if opts != nil {
+		if opts.SortBy != "" {
+			query["sortBy"] = opts.SortBy
+		}
+		if opts.IsDsc != nil {
+			query["isDsc"] = *opts.IsDsc
+		}
+		if opts.ViewContext != "" {
+			query["viewContext"] = opts.ViewContext
+		}
+		iterateOpts = IterateOptions{Count: opts.Count, MaxItems: opts.MaxItems}
+	}
+
+	return Iterate[ElementPost](ctx, s.session, "/posts", query, &iterateOpts)
+}
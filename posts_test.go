@@ -0,0 +1,194 @@
+package linkedin
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+)
+
+// newPostsTestSession returns a session pointing to the given handler
+func newPostsTestSession(t *testing.T, handler http.HandlerFunc) *Session {
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	session := New("client", "secret").Session("token")
+	session.BaseURL = server.URL
+	return session
+}
+
+// TestPostsCreate tests creating an article post
+func TestPostsCreate(t *testing.T) {
+	var body map[string]interface{}
+	session := newPostsTestSession(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != "/posts" || r.Header.Get(string(RestLiMethodHeader)) != string(Create) {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		_ = json.NewDecoder(r.Body).Decode(&body)
+		w.Header().Set(string(CreatedEntityID), "urn:li:share:6844785523593134080")
+		w.WriteHeader(http.StatusCreated)
+	})
+
+	urn, err := session.Posts().CreateArticle(context.Background(), OrganizationURN(5515715), "Read our blog", ArticleContentPost{
+		Source: "https://example.com/blog",
+		Title:  "Blog",
+	})
+	if err != nil || urn != ShareURN("6844785523593134080") {
+		t.Fatalf("CreateArticle() = %v, %v; want urn:li:share:6844785523593134080", urn, err)
+	}
+
+	if body["author"] != "urn:li:organization:5515715" || body["visibility"] != VisibilityPublic ||
+		body["lifecycleState"] != LifecycleStatePublished {
+		t.Errorf("unexpected request body %v", body)
+	}
+	article := body["content"].(map[string]interface{})["article"].(map[string]interface{})
+	if article["source"] != "https://example.com/blog" || article["title"] != "Blog" {
+		t.Errorf("unexpected article %v", article)
+	}
+	if _, ok := body["reshareContext"]; ok {
+		t.Errorf("request body contains reshareContext: %v", body)
+	}
+}
+
+// TestPostsCreateScopes tests failing fast without the required scope
+func TestPostsCreateScopes(t *testing.T) {
+	session := newPostsTestSession(t, func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("unexpected request %s %s", r.Method, r.URL)
+	})
+	session.SetGrantedScopes(NewScopeSet(ScopeMemberSocialWrite))
+
+	_, err := session.Posts().CreateText(context.Background(), OrganizationURN(1), "Hello")
+	var scopeErr *ScopeError
+	if !errors.As(err, &scopeErr) {
+		t.Errorf("CreateText() error = %v; want *ScopeError", err)
+	}
+}
+
+// TestPostsGet tests getting a post with an encoded URN in the path
+func TestPostsGet(t *testing.T) {
+	session := newPostsTestSession(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet || r.URL.EscapedPath() != "/posts/urn%3Ali%3Ashare%3A123" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.EscapedPath())
+		}
+		_, _ = w.Write([]byte(`{"id":"urn:li:share:123","author":"urn:li:person:abc","commentary":"Hello"}`))
+	})
+
+	post, err := session.Posts().Get(context.Background(), ShareURN("123"))
+	if err != nil || post.Commentary != "Hello" || post.ID != "urn:li:share:123" {
+		t.Errorf("Get() = %+v, %v; want commentary Hello", post, err)
+	}
+}
+
+// TestPostsBatchGet tests batch getting posts with per-key errors
+func TestPostsBatchGet(t *testing.T) {
+	session := newPostsTestSession(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet || r.Header.Get(string(RestLiMethodHeader)) != string(BatchGet) {
+			t.Errorf("unexpected request %s %s", r.Method, r.Header.Get(string(RestLiMethodHeader)))
+		}
+		if got, want := r.URL.RawQuery, "ids=List(urn%3Ali%3Ashare%3A1,urn%3Ali%3Ashare%3A2)"; got != want {
+			t.Errorf("query = %s; want %s", got, want)
+		}
+		_, _ = w.Write([]byte(`{
+			"results": {"urn:li:share:1": {"id": "urn:li:share:1", "commentary": "One"}},
+			"statuses": {"urn:li:share:1": 200, "urn%3Ali%3Ashare%3A2": 404},
+			"errors": {"urn%3Ali%3Ashare%3A2": {"status": 404, "message": "Not found"}}
+		}`))
+	})
+
+	result, err := session.Posts().BatchGet(context.Background(), []URN{ShareURN("1"), ShareURN("2")})
+	if err != nil {
+		t.Fatalf("BatchGet() error = %v", err)
+	}
+	if post := result.Results[ShareURN("1")]; post.Commentary != "One" {
+		t.Errorf("Results[urn:li:share:1] = %+v; want commentary One", post)
+	}
+	apiErr := result.Errors[ShareURN("2")]
+	if apiErr == nil || apiErr.StatusCode != http.StatusNotFound || apiErr.Message != "Not found" {
+		t.Errorf("Errors[urn:li:share:2] = %+v; want 404 Not found", apiErr)
+	}
+	if result.Statuses[ShareURN("2")] != http.StatusNotFound {
+		t.Errorf("Statuses[urn:li:share:2] = %d; want 404", result.Statuses[ShareURN("2")])
+	}
+}
+
+// TestPostsUpdate tests editing the commentary of a post
+func TestPostsUpdate(t *testing.T) {
+	var body map[string]interface{}
+	session := newPostsTestSession(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.Header.Get(string(RestLiMethodHeader)) != string(PartialUpdate) ||
+			r.URL.EscapedPath() != "/posts/urn%3Ali%3AugcPost%3A7" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.EscapedPath())
+		}
+		_ = json.NewDecoder(r.Body).Decode(&body)
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	commentary := "Edited"
+	if err := session.Posts().Update(context.Background(), UGCPostURN("7"), PostPatch{Commentary: &commentary}); err != nil {
+		t.Fatalf("Update() error = %v", err)
+	}
+	set := body["patch"].(map[string]interface{})["$set"].(map[string]interface{})
+	if len(set) != 1 || set["commentary"] != "Edited" {
+		t.Errorf("patch.$set = %v; want commentary only", set)
+	}
+}
+
+// TestPostsDelete tests deleting a post
+func TestPostsDelete(t *testing.T) {
+	session := newPostsTestSession(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodDelete || r.Header.Get(string(RestLiMethodHeader)) != string(Delete) ||
+			r.URL.EscapedPath() != "/posts/urn%3Ali%3Ashare%3A9" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.EscapedPath())
+		}
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	if err := session.Posts().Delete(context.Background(), ShareURN("9")); err != nil {
+		t.Errorf("Delete() error = %v", err)
+	}
+}
+
+// TestPostsFindByAuthor tests the author finder and decoding of post content
+func TestPostsFindByAuthor(t *testing.T) {
+	var query string
+	session := newPostsTestSession(t, func(w http.ResponseWriter, r *http.Request) {
+		query = r.URL.RawQuery
+		_, _ = w.Write([]byte(`{
+			"paging": {"start": 0, "count": 2, "total": 2},
+			"elements": [
+				{"id": "urn:li:share:1", "content": {"media": {"id": "urn:li:image:C4E10AQ", "altText": "Logo"}}},
+				{"id": "urn:li:share:2", "content": {"poll": {"question": "Go?", "options": [{"text": "Yes"}, {"text": "No"}], "settings": {"duration": "THREE_DAYS"}}}}
+			]
+		}`))
+	})
+
+	isDsc := false
+	it := session.Posts().FindByAuthor(context.Background(), OrganizationURN(5515715), &FindPostsOptions{
+		SortBy:      SortByCreated,
+		IsDsc:       &isDsc,
+		ViewContext: ViewContextAuthor,
+		Count:       2,
+	})
+	posts, err := it.All()
+	if err != nil || len(posts) != 2 {
+		t.Fatalf("All() = %d posts, %v; want 2", len(posts), err)
+	}
+
+	values, _ := url.ParseQuery(query)
+	if values.Get("q") != "author" || values.Get("author") != "urn:li:organization:5515715" ||
+		values.Get("sortBy") != SortByCreated || values.Get("isDsc") != "false" ||
+		values.Get("viewContext") != ViewContextAuthor || values.Get("count") != "2" {
+		t.Errorf("unexpected query %s", query)
+	}
+
+	if media := posts[0].Content.Media; media == nil || media.ID != "urn:li:image:C4E10AQ" || media.AltText != "Logo" {
+		t.Errorf("Content.Media = %+v; want urn:li:image:C4E10AQ", media)
+	}
+	if poll := posts[1].Content.Poll; poll == nil || len(poll.Options) != 2 || poll.Settings.Duration != "THREE_DAYS" {
+		t.Errorf("Content.Poll = %+v; want two options", poll)
+	}
+}
@@ -0,0 +1,279 @@
+package linkedin
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"math"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+)
+
+// ErrRateLimitExceeded is returned by a fail-fast rate limiter
+// when the budget for a request is exhausted.
+var ErrRateLimitExceeded = errors.New("linkedIn: client-side rate limit exceeded")
+
+// RateLimitKey identifies the budgets a request is counted against.
+//
+// LinkedIn enforces daily limits per application and per member for each endpoint.
+//
+// See: https://learn.microsoft.com/en-us/linkedin/shared/api-guide/concepts/rate-limits
+type RateLimitKey struct {
+	Endpoint string // endpoint family, e.g. posts
+	Member   string // member ID set with Session.SetMemberID, or a hash of the credentials, empty for unauthenticated calls
+}
+
+// RateLimiter limits requests sent to LinkedIn API.
+//
+// Implement this interface to share the budget across processes,
+// e.g. backed by Redis.
+type RateLimiter interface {
+	// Wait blocks until a request for key is allowed, or returns an error
+	// if the request must not be sent.
+	Wait(ctx context.Context, key RateLimitKey) error
+	// Remaining returns the remaining application and member budget for key.
+	Remaining(key RateLimitKey) (app, member int)
+}
+
+// RateLimit - number of requests allowed per period.
+type RateLimit struct {
+	Requests int
+	Per      time.Duration
+}
+
+// Daily returns a rate limit of n requests per 24 hours.
+func Daily(n int) RateLimit {
+	return RateLimit{Requests: n, Per: 24 * time.Hour}
+}
+
+// TokenBucketLimiter is an in-memory RateLimiter using token buckets
+// per endpoint family (application limit) and per endpoint family and
+// access token (member limit).
+type TokenBucketLimiter struct {
+	App       RateLimit            // default application limit per endpoint family
+	Member    RateLimit            // default member limit per endpoint family
+	Endpoints map[string]RateLimit // application limit overrides per endpoint family
+	Members   map[string]RateLimit // member limit overrides per endpoint family
+	FailFast  bool                 // return ErrRateLimitExceeded instead of blocking
+
+	mu      sync.Mutex
+	buckets map[string]*bucket
+	now     func() time.Time
+}
+
+// bucket - a token bucket
+type bucket struct {
+	tokens float64
+	last   time.Time
+	limit  RateLimit
+}
+
+// NewTokenBucketLimiter creates an in-memory rate limiter with
+// default application and member limits per endpoint family.
+func NewTokenBucketLimiter(app, member RateLimit) *TokenBucketLimiter {
+	return &TokenBucketLimiter{
+		App:    app,
+		Member: member,
+	}
+}
+
+// Wait implements RateLimiter.
+func (l *TokenBucketLimiter) Wait(ctx context.Context, key RateLimitKey) error {
+	for {
+		wait, ok := l.reserve(key)
+		if ok {
+			return nil
+		}
+		if l.FailFast {
+			return ErrRateLimitExceeded
+		}
+		if deadline, ok := ctx.Deadline(); ok && time.Until(deadline) < wait {
+			return ErrRateLimitExceeded
+		}
+
+		timer := time.NewTimer(wait)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
+
+// Remaining implements RateLimiter.
+// A negative value means the budget is not limited.
+func (l *TokenBucketLimiter) Remaining(key RateLimitKey) (app, member int) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	app, member = -1, -1
+	now := l.clock()
+	if b := l.bucket(l.appKey(key), l.appLimit(key)); b != nil {
+		app = int(b.refill(now))
+	}
+	if b := l.bucket(l.memberKey(key), l.memberLimit(key)); b != nil {
+		member = int(b.refill(now))
+	}
+	return
+}
+
+// reserve takes a token from the application and member buckets.
+// If a bucket is empty, it returns the time until a token is available.
+func (l *TokenBucketLimiter) reserve(key RateLimitKey) (time.Duration, bool) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := l.clock()
+	var wait time.Duration
+	var buckets []*bucket
+	for _, b := range []*bucket{l.bucket(l.appKey(key), l.appLimit(key)), l.bucket(l.memberKey(key), l.memberLimit(key))} {
+		if b == nil {
+			continue
+		}
+		buckets = append(buckets, b)
+		if d := b.wait(now); d > wait {
+			wait = d
+		}
+	}
+	if wait > 0 {
+		return wait, false
+	}
+
+	for _, b := range buckets {
+		b.tokens--
+	}
+	return 0, true
+}
+
+// appKey returns the application bucket key.
+func (l *TokenBucketLimiter) appKey(key RateLimitKey) string {
+	return "app:" + key.Endpoint
+}
+
+// memberKey returns the member bucket key, empty for unauthenticated calls.
+func (l *TokenBucketLimiter) memberKey(key RateLimitKey) string {
+	if key.Member == "" {
+		return ""
+	}
+	return "member:" + key.Endpoint + ":" + key.Member
+}
+
+// appLimit returns the application limit of the endpoint family of key.
+func (l *TokenBucketLimiter) appLimit(key RateLimitKey) RateLimit {
+	if override, ok := l.Endpoints[key.Endpoint]; ok {
+		return override
+	}
+	return l.App
+}
+
+// memberLimit returns the member limit of the endpoint family of key.
+func (l *TokenBucketLimiter) memberLimit(key RateLimitKey) RateLimit {
+	if override, ok := l.Members[key.Endpoint]; ok {
+		return override
+	}
+	return l.Member
+}
+
+// bucket returns the bucket for k with the given limit, creating it on first use.
+// It returns nil if k is not limited.
+func (l *TokenBucketLimiter) bucket(k string, limit RateLimit) *bucket {
+	if k == "" {
+		return nil
+	}
+	if b, ok := l.buckets[k]; ok {
+		return b
+	}
+	if limit.Requests <= 0 || limit.Per <= 0 {
+		return nil
+	}
+
+	if l.buckets == nil {
+		l.buckets = make(map[string]*bucket)
+	}
+	b := &bucket{tokens: float64(limit.Requests), last: l.clock(), limit: limit}
+	l.buckets[k] = b
+	return b
+}
+
+// clock returns the current time.
+func (l *TokenBucketLimiter) clock() time.Time {
+	if l.now != nil {
+		return l.now()
+	}
+	return time.Now()
+}
+
+// refill adds tokens accrued since the last refill and returns the available tokens.
+func (b *bucket) refill(now time.Time) float64 {
+	if now.Before(b.last) {
+		return b.tokens
+	}
+	rate := float64(b.limit.Requests) / float64(b.limit.Per)
+	b.tokens = math.Min(float64(b.limit.Requests), b.tokens+float64(now.Sub(b.last))*rate)
+	b.last = now
+	return b.tokens
+}
+
+// wait returns the time until a token is available.
+func (b *bucket) wait(now time.Time) time.Duration {
+	tokens := b.refill(now)
+	if tokens >= 1 {
+		return 0
+	}
+	rate := float64(b.limit.Requests) / float64(b.limit.Per)
+	return time.Duration(math.Ceil((1 - tokens) / rate))
+}
+
+// SetRateLimiter sets the client-side rate limiter of the session.
+// A nil limiter disables client-side rate limiting.
+func (session *Session) SetRateLimiter(limiter RateLimiter) {
+	session.rateLimiter = limiter
+}
+
+// SetMemberID sets a stable identity of the member, e.g. the person URN,
+// to count requests against the member budgets of the rate limiter.
+//
+// Otherwise the refresh token of a RefreshingTokenSource, or else the access token,
+// identifies the member. Unlike the access token, the member ID stays the same
+// when the access token is refreshed, just like the quota of LinkedIn.
+func (session *Session) SetMemberID(id string) {
+	session.memberID = id
+}
+
+// rateLimitKey returns the rate limit key of a request to the versioned LinkedIn API
+// sent with accessToken.
+func (session *Session) rateLimitKey(u *url.URL, accessToken string) RateLimitKey {
+	path := u.Path
+	if base, err := url.Parse(session.BaseURL); err == nil {
+		path = strings.TrimPrefix(path, strings.TrimSuffix(base.Path, "/"))
+	}
+	path = strings.TrimPrefix(path, "/")
+	if i := strings.IndexAny(path, "/("); i >= 0 {
+		path = path[:i]
+	}
+
+	return RateLimitKey{Endpoint: path, Member: session.rateLimitMember(accessToken)}
+}
+
+// rateLimitMember returns the member of the rate limit key, empty for unauthenticated calls.
+func (session *Session) rateLimitMember(accessToken string) string {
+	if session.memberID != "" {
+		return session.memberID
+	}
+
+	credential := accessToken
+	if ts, ok := session.tokenSource.(*RefreshingTokenSource); ok {
+		if refreshToken := ts.refreshToken(); refreshToken != "" {
+			credential = refreshToken
+		}
+	}
+	if credential == "" {
+		return ""
+	}
+	sum := sha256.Sum256([]byte(credential))
+	return hex.EncodeToString(sum[:8])
+}
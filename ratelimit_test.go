@@ -0,0 +1,162 @@
+package linkedin
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+)
+
+// TestTokenBucketLimiter tests application and member budgets
+func TestTokenBucketLimiter(t *testing.T) {
+	now := time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC)
+	limiter := NewTokenBucketLimiter(Daily(3), Daily(2))
+	limiter.FailFast = true
+	limiter.now = func() time.Time { return now }
+
+	alice := RateLimitKey{Endpoint: "posts", Member: "alice"}
+	bob := RateLimitKey{Endpoint: "posts", Member: "bob"}
+	ctx := context.Background()
+
+	for i := 0; i < 2; i++ {
+		if err := limiter.Wait(ctx, alice); err != nil {
+			t.Fatalf("Wait(alice) #%d error = %v; want nil", i+1, err)
+		}
+	}
+	if err := limiter.Wait(ctx, alice); !errors.Is(err, ErrRateLimitExceeded) {
+		t.Errorf("Wait(alice) error = %v; want ErrRateLimitExceeded", err)
+	}
+	if err := limiter.Wait(ctx, bob); err != nil {
+		t.Errorf("Wait(bob) error = %v; want nil", err)
+	}
+	if err := limiter.Wait(ctx, bob); !errors.Is(err, ErrRateLimitExceeded) {
+		t.Errorf("Wait(bob) error = %v; want ErrRateLimitExceeded (app budget)", err)
+	}
+
+	app, member := limiter.Remaining(alice)
+	if app != 0 || member != 0 {
+		t.Errorf("Remaining(alice) = %d, %d; want 0, 0", app, member)
+	}
+
+	// budget is refilled after a day
+	now = now.Add(24 * time.Hour)
+	app, member = limiter.Remaining(alice)
+	if app != 3 || member != 2 {
+		t.Errorf("Remaining(alice) = %d, %d; want 3, 2", app, member)
+	}
+}
+
+// TestTokenBucketLimiterMemberOverride tests member overrides with URN member IDs
+func TestTokenBucketLimiterMemberOverride(t *testing.T) {
+	limiter := NewTokenBucketLimiter(RateLimit{}, Daily(100))
+	limiter.Members = map[string]RateLimit{"posts": Daily(1)}
+	limiter.FailFast = true
+
+	key := RateLimitKey{Endpoint: "posts", Member: "urn:li:person:abc"}
+	if err := limiter.Wait(context.Background(), key); err != nil {
+		t.Fatalf("Wait() error = %v", err)
+	}
+	if _, member := limiter.Remaining(key); member != 0 {
+		t.Errorf("Remaining() member = %d; want 0", member)
+	}
+	if err := limiter.Wait(context.Background(), key); !errors.Is(err, ErrRateLimitExceeded) {
+		t.Errorf("Wait() error = %v; want ErrRateLimitExceeded", err)
+	}
+}
+
+// TestRateLimitKey tests deriving endpoint families from request URLs
+func TestRateLimitKey(t *testing.T) {
+	session := New("id", "secret").Session("token")
+	tests := []struct {
+		rawURL string
+		want   string
+	}{
+		{"https://api.linkedin.com/rest/posts?q=author", "posts"},
+		{"https://api.linkedin.com/rest/posts/urn%3Ali%3Ashare%3A1", "posts"},
+		{"https://api.linkedin.com/rest/organizationAcls?q=roleAssignee", "organizationAcls"},
+		{"https://api.linkedin.com/rest/images?action=initializeUpload", "images"},
+	}
+
+	for _, test := range tests {
+		u, _ := url.Parse(test.rawURL)
+		key := session.rateLimitKey(u, "token")
+		if key.Endpoint != test.want || key.Member == "" {
+			t.Errorf("rateLimitKey(%s) = %+v; want endpoint %s", test.rawURL, key, test.want)
+		}
+	}
+}
+
+// countingTokenSource counts the calls of Token
+type countingTokenSource struct {
+	calls int
+}
+
+// Token implements TokenSource.
+func (ts *countingTokenSource) Token() (Token, error) {
+	ts.calls++
+	return Token{AccessToken: "token"}, nil
+}
+
+// recordingLimiter records the keys of the requests
+type recordingLimiter struct {
+	keys []RateLimitKey
+}
+
+// Wait implements RateLimiter.
+func (l *recordingLimiter) Wait(ctx context.Context, key RateLimitKey) error {
+	l.keys = append(l.keys, key)
+	return nil
+}
+
+// Remaining implements RateLimiter.
+func (l *recordingLimiter) Remaining(key RateLimitKey) (int, int) {
+	return -1, -1
+}
+
+// TestRateLimitMember tests keying member budgets by a stable identity
+func TestRateLimitMember(t *testing.T) {
+	var refreshes int32
+	app := newTokenTestApp(t, &refreshes)
+	api := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{}`))
+	}))
+	defer api.Close()
+
+	// the token source is asked once per request
+	counting := &countingTokenSource{}
+	limiter := &recordingLimiter{}
+	session := app.Session("")
+	session.BaseURL = api.URL
+	session.SetRateLimiter(limiter)
+	session.SetTokenSource(counting)
+	if _, _, err := session.Get("/posts"); err != nil {
+		t.Fatalf("Get() error = %v", err)
+	}
+	if counting.calls != 1 || len(limiter.keys) != 1 || limiter.keys[0].Member == "" {
+		t.Errorf("Token() calls = %d, keys = %v; want 1 call and a member key", counting.calls, limiter.keys)
+	}
+
+	// the member key survives a refresh of the access token
+	ts := NewTokenSource(app, Token{AccessToken: "access-0", ExpiresIn: 5184000, RefreshToken: "refresh"})
+	session.SetTokenSource(ts)
+	limiter.keys = nil
+	_, _, _ = session.Get("/posts")
+	if _, err := ts.Refresh(); err != nil {
+		t.Fatalf("Refresh() error = %v", err)
+	}
+	_, _, _ = session.Get("/posts")
+	if len(limiter.keys) != 2 || limiter.keys[0].Member != limiter.keys[1].Member {
+		t.Errorf("keys = %v; want the same member before and after refresh", limiter.keys)
+	}
+
+	// an explicit member ID takes precedence
+	session.SetMemberID("urn:li:person:abc")
+	limiter.keys = nil
+	_, _, _ = session.Get("/posts")
+	if len(limiter.keys) != 1 || limiter.keys[0].Member != "urn:li:person:abc" {
+		t.Errorf("keys = %v; want member urn:li:person:abc", limiter.keys)
+	}
+}
@@ -0,0 +1,73 @@
+package linkedin
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+// Response - response from LinkedIn API call request.
+type Response struct {
+	StatusCode   int            // HTTP status code
+	Header       http.Header    // response headers
+	Body         []byte         // raw response body
+	HTTPResponse *http.Response // underlying HTTP response, body is already consumed
+}
+
+// newResponse wraps an HTTP response and its body.
+func newResponse(response *http.Response, body []byte) *Response {
+	return &Response{
+		StatusCode:   response.StatusCode,
+		Header:       response.Header,
+		Body:         body,
+		HTTPResponse: response,
+	}
+}
+
+// Decode parses the JSON response body into v.
+func (r *Response) Decode(v interface{}) error {
+	if len(r.Body) == 0 {
+		return fmt.Errorf("linkedIn: response body is empty")
+	}
+	if err := json.Unmarshal(r.Body, v); err != nil {
+		return fmt.Errorf("linkedIn: cannot decode response body; %w", err)
+	}
+	return nil
+}
+
+// Result parses the JSON response body into a generic Result.
+func (r *Response) Result() (Result, error) {
+	var result Result
+	err := r.Decode(&result)
+	return result, err
+}
+
+// CreatedEntityID returns the ID of the entity created by a CREATE request.
+func (r *Response) CreatedEntityID() string {
+	return r.Header.Get(string(CreatedEntityID))
+}
+
+// BatchResult struct for the response of a Rest.li BATCH_GET request
+//
+// Results, statuses and errors are keyed by the requested entity,
+// e.g. by URN. Keys missing from Results have an entry in Errors.
+//
+// See: https://linkedin.github.io/rest.li/spec/protocol#batch-get
+type BatchResult[K comparable, T any] struct {
+	Results  map[K]T         `json:"results"`
+	Statuses map[K]int       `json:"statuses"`
+	Errors   map[K]*APIError `json:"errors"`
+}
+
+// setErrorStatus copies the status of the per-key errors to APIError.StatusCode.
+func (b *BatchResult[K, T]) setErrorStatus() {
+	for key, apiErr := range b.Errors {
+		if apiErr == nil {
+			continue
+		}
+		apiErr.StatusCode = apiErr.Status
+		if apiErr.StatusCode == 0 {
+			apiErr.StatusCode = b.Statuses[key]
+		}
+	}
+}
@@ -0,0 +1,336 @@
+package linkedin
+
+import (
+	"encoding"
+	"fmt"
+	"net/url"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// EncodeRestLi encodes a Go value into Rest.li protocol 2.0.0 format.
+//
+// Slices and arrays are encoded as `List(a,b)`, maps and structs as `(k1:v1,k2:v2)`.
+// Struct fields are named after their `json` tag, `omitempty` and `-` are honored.
+// Values implementing encoding.TextMarshaler (e.g. URN) are encoded as strings.
+// Reserved characters in strings are percent-encoded and
+// the empty string is encoded as two single quotes.
+//
+// See: https://linkedin.github.io/rest.li/spec/protocol#restli-protocol-20-object-and-listarray-representation
+func EncodeRestLi(v interface{}) (string, error) {
+	var sb strings.Builder
+	if err := encodeRestLi(&sb, reflect.ValueOf(v)); err != nil {
+		return "", err
+	}
+	return sb.String(), nil
+}
+
+// Encode encodes params into a Rest.li protocol 2.0.0 query string,
+// sorted by key. Nil values are skipped.
+func (params Params) Encode() (string, error) {
+	keys := make([]string, 0, len(params))
+	for key, value := range params {
+		if value == nil {
+			continue
+		}
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	pairs := make([]string, 0, len(keys))
+	for _, key := range keys {
+		value, err := EncodeRestLi(params[key])
+		if err != nil {
+			return "", fmt.Errorf("linkedIn: cannot encode query parameter %s; %w", key, err)
+		}
+		pairs = append(pairs, escapeRestLi(key)+"="+value)
+	}
+	return strings.Join(pairs, "&"), nil
+}
+
+// textMarshalerType - reflect type of encoding.TextMarshaler
+var textMarshalerType = reflect.TypeOf((*encoding.TextMarshaler)(nil)).Elem()
+
+// encodeRestLi writes the Rest.li encoding of v into sb.
+func encodeRestLi(sb *strings.Builder, v reflect.Value) error {
+	if !v.IsValid() {
+		sb.WriteString("''")
+		return nil
+	}
+
+	if v.Type().Implements(textMarshalerType) {
+		if v.Kind() == reflect.Ptr && v.IsNil() {
+			sb.WriteString("''")
+			return nil
+		}
+		text, err := v.Interface().(encoding.TextMarshaler).MarshalText()
+		if err != nil {
+			return err
+		}
+		sb.WriteString(escapeRestLi(string(text)))
+		return nil
+	}
+
+	switch v.Kind() {
+	case reflect.Ptr, reflect.Interface:
+		if v.IsNil() {
+			sb.WriteString("''")
+			return nil
+		}
+		return encodeRestLi(sb, v.Elem())
+
+	case reflect.String:
+		sb.WriteString(escapeRestLi(v.String()))
+
+	case reflect.Bool:
+		sb.WriteString(strconv.FormatBool(v.Bool()))
+
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		sb.WriteString(strconv.FormatInt(v.Int(), 10))
+
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		sb.WriteString(strconv.FormatUint(v.Uint(), 10))
+
+	case reflect.Float32, reflect.Float64:
+		sb.WriteString(strconv.FormatFloat(v.Float(), 'f', -1, 64))
+
+	case reflect.Slice, reflect.Array:
+		sb.WriteString(ListPrefix)
+		for i := 0; i < v.Len(); i++ {
+			if i > 0 {
+				sb.WriteString(ListItemSep)
+			}
+			if err := encodeRestLi(sb, v.Index(i)); err != nil {
+				return err
+			}
+		}
+		sb.WriteString(ListSuffix)
+
+	case reflect.Map:
+		if v.Type().Key().Kind() != reflect.String {
+			return fmt.Errorf("linkedIn: unsupported map key type %s", v.Type().Key())
+		}
+		keys := v.MapKeys()
+		sort.Slice(keys, func(i, j int) bool { return keys[i].String() < keys[j].String() })
+
+		sb.WriteString(ObjPrefix)
+		for i, key := range keys {
+			if i > 0 {
+				sb.WriteString(ObjKeyValPairSep)
+			}
+			sb.WriteString(escapeRestLi(key.String()))
+			sb.WriteString(ObjKeyValSep)
+			if err := encodeRestLi(sb, v.MapIndex(key)); err != nil {
+				return err
+			}
+		}
+		sb.WriteString(ObjSuffix)
+
+	case reflect.Struct:
+		sb.WriteString(ObjPrefix)
+		first := true
+		for i := 0; i < v.NumField(); i++ {
+			field := v.Type().Field(i)
+			if field.PkgPath != "" {
+				continue // unexported
+			}
+			name, omitEmpty := jsonFieldName(field)
+			if name == "-" {
+				continue
+			}
+			value := v.Field(i)
+			if omitEmpty && value.IsZero() {
+				continue
+			}
+
+			if !first {
+				sb.WriteString(ObjKeyValPairSep)
+			}
+			first = false
+			sb.WriteString(escapeRestLi(name))
+			sb.WriteString(ObjKeyValSep)
+			if err := encodeRestLi(sb, value); err != nil {
+				return err
+			}
+		}
+		sb.WriteString(ObjSuffix)
+
+	default:
+		return fmt.Errorf("linkedIn: cannot encode %s in Rest.li format", v.Type())
+	}
+
+	return nil
+}
+
+// jsonFieldName returns the name of a struct field from its `json` tag.
+func jsonFieldName(field reflect.StructField) (name string, omitEmpty bool) {
+	tag := field.Tag.Get("json")
+	if tag == "-" {
+		return "-", false
+	}
+
+	parts := strings.Split(tag, ",")
+	name = parts[0]
+	if name == "" {
+		name = field.Name
+	}
+	for _, option := range parts[1:] {
+		if option == "omitempty" {
+			omitEmpty = true
+		}
+	}
+	return name, omitEmpty
+}
+
+// escapeRestLi percent-encodes all characters except unreserved ones,
+// including the Rest.li reserved characters `(`, `)`, `,`, `:` and `'`.
+func escapeRestLi(s string) string {
+	if s == "" {
+		return "''"
+	}
+
+	const hex = "0123456789ABCDEF"
+	var sb strings.Builder
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		if isUnreserved(c) {
+			sb.WriteByte(c)
+			continue
+		}
+		sb.WriteByte('%')
+		sb.WriteByte(hex[c>>4])
+		sb.WriteByte(hex[c&15])
+	}
+	return sb.String()
+}
+
+// isUnreserved reports whether c is an unreserved URI character.
+func isUnreserved(c byte) bool {
+	return 'a' <= c && c <= 'z' || 'A' <= c && c <= 'Z' || '0' <= c && c <= '9' ||
+		c == '-' || c == '_' || c == '.' || c == '~'
+}
+
+// DecodeRestLi parses a Rest.li protocol 2.0.0 encoded value.
+//
+// Lists are decoded as []interface{}, objects as map[string]interface{}
+// and all primitives as unescaped strings.
+func DecodeRestLi(s string) (interface{}, error) {
+	d := &restLiDecoder{s: s}
+	v, err := d.value()
+	if err != nil {
+		return nil, err
+	}
+	if d.pos != len(d.s) {
+		return nil, fmt.Errorf("linkedIn: unexpected %q at position %d in Rest.li value", d.s[d.pos], d.pos)
+	}
+	return v, nil
+}
+
+// restLiDecoder - recursive descent parser for Rest.li encoded values
+type restLiDecoder struct {
+	s   string
+	pos int
+}
+
+// value parses a list, an object or a primitive.
+func (d *restLiDecoder) value() (interface{}, error) {
+	rest := d.s[d.pos:]
+	switch {
+	case strings.HasPrefix(rest, ListPrefix):
+		d.pos += len(ListPrefix)
+		return d.list()
+	case strings.HasPrefix(rest, ObjPrefix):
+		d.pos += len(ObjPrefix)
+		return d.object()
+	default:
+		return d.primitive()
+	}
+}
+
+// list parses the items of a list after `List(`.
+func (d *restLiDecoder) list() (interface{}, error) {
+	items := []interface{}{}
+	if d.consume(ListSuffix) {
+		return items, nil
+	}
+	for {
+		item, err := d.value()
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, item)
+
+		if d.consume(ListSuffix) {
+			return items, nil
+		}
+		if !d.consume(ListItemSep) {
+			return nil, d.unexpected()
+		}
+	}
+}
+
+// object parses the key-value pairs of an object after `(`.
+func (d *restLiDecoder) object() (interface{}, error) {
+	obj := map[string]interface{}{}
+	if d.consume(ObjSuffix) {
+		return obj, nil
+	}
+	for {
+		key, err := d.primitive()
+		if err != nil {
+			return nil, err
+		}
+		if !d.consume(ObjKeyValSep) {
+			return nil, d.unexpected()
+		}
+		value, err := d.value()
+		if err != nil {
+			return nil, err
+		}
+		obj[key] = value
+
+		if d.consume(ObjSuffix) {
+			return obj, nil
+		}
+		if !d.consume(ObjKeyValPairSep) {
+			return nil, d.unexpected()
+		}
+	}
+}
+
+// primitive parses an escaped string up to the next reserved character.
+func (d *restLiDecoder) primitive() (string, error) {
+	start := d.pos
+	for d.pos < len(d.s) && !strings.ContainsRune("(),:", rune(d.s[d.pos])) {
+		d.pos++
+	}
+
+	raw := d.s[start:d.pos]
+	if raw == "''" {
+		return "", nil
+	}
+	value, err := url.PathUnescape(raw)
+	if err != nil {
+		return "", fmt.Errorf("linkedIn: invalid escaping in Rest.li value %q; %w", raw, err)
+	}
+	return value, nil
+}
+
+// consume advances past token if the input continues with it.
+func (d *restLiDecoder) consume(token string) bool {
+	if strings.HasPrefix(d.s[d.pos:], token) {
+		d.pos += len(token)
+		return true
+	}
+	return false
+}
+
+// unexpected returns an error for the current position.
+func (d *restLiDecoder) unexpected() error {
+	if d.pos >= len(d.s) {
+		return fmt.Errorf("linkedIn: unexpected end of Rest.li value")
+	}
+	return fmt.Errorf("linkedIn: unexpected %q at position %d in Rest.li value", d.s[d.pos], d.pos)
+}
@@ -0,0 +1,81 @@
+package linkedin
+
+import (
+	"reflect"
+	"testing"
+)
+
+// TestEncodeRestLi tests encoding Go values in Rest.li protocol 2.0.0 format
+func TestEncodeRestLi(t *testing.T) {
+	type timeRange struct {
+		Start int64 `json:"start"`
+		End   int64 `json:"end,omitempty"`
+	}
+
+	tests := []struct {
+		name  string
+		value interface{}
+		want  string
+	}{
+		{"string", "urn:li:organization:1", "urn%3Ali%3Aorganization%3A1"},
+		{"empty string", "", "''"},
+		{"reserved", "a (b), c's", "a%20%28b%29%2C%20c%27s"},
+		{"bool", true, "true"},
+		{"int", 100, "100"},
+		{"list", []string{"urn:li:share:1", "urn:li:share:2"}, "List(urn%3Ali%3Ashare%3A1,urn%3Ali%3Ashare%3A2)"},
+		{"empty list", []int{}, "List()"},
+		{"map", map[string]interface{}{"b": 2, "a": "x"}, "(a:x,b:2)"},
+		{"struct", timeRange{Start: 1}, "(start:1)"},
+		{"nested", Params{"timeRange": timeRange{Start: 1, End: 2}, "ids": []int{1}}, "(ids:List(1),timeRange:(start:1,end:2))"},
+	}
+
+	for _, test := range tests {
+		got, err := EncodeRestLi(test.value)
+		if err != nil || got != test.want {
+			t.Errorf("%s: EncodeRestLi() = %s, %v; want %s, nil", test.name, got, err, test.want)
+		}
+	}
+}
+
+// TestParamsEncode tests encoding query parameters
+func TestParamsEncode(t *testing.T) {
+	params := Params{
+		"q":      "author",
+		"author": "urn:li:organization:1",
+		"ids":    []string{"1", "2"},
+		"start":  nil,
+	}
+
+	got, err := params.Encode()
+	want := "author=urn%3Ali%3Aorganization%3A1&ids=List(1,2)&q=author"
+	if err != nil || got != want {
+		t.Errorf("Encode() = %s, %v; want %s, nil", got, err, want)
+	}
+}
+
+// TestDecodeRestLi tests decoding Rest.li encoded values
+func TestDecodeRestLi(t *testing.T) {
+	tests := []struct {
+		value string
+		want  interface{}
+	}{
+		{"urn%3Ali%3Aorganization%3A1", "urn:li:organization:1"},
+		{"''", ""},
+		{"List(1,2)", []interface{}{"1", "2"}},
+		{"List()", []interface{}{}},
+		{"(a:x,b:List((c:1)))", map[string]interface{}{"a": "x", "b": []interface{}{map[string]interface{}{"c": "1"}}}},
+	}
+
+	for _, test := range tests {
+		got, err := DecodeRestLi(test.value)
+		if err != nil || !reflect.DeepEqual(got, test.want) {
+			t.Errorf("DecodeRestLi(%s) = %v, %v; want %v, nil", test.value, got, err, test.want)
+		}
+	}
+
+	for _, invalid := range []string{"List(1,2", "(a)", "(a:1))", "%zz"} {
+		if _, err := DecodeRestLi(invalid); err == nil {
+			t.Errorf("DecodeRestLi(%s) error = nil; want error", invalid)
+		}
+	}
+}
@@ -1,17 +1,38 @@
 package linkedin
 
+import (
+	"strings"
+	"time"
+)
+
 // Result - response body from LinkedIn API call request.
 type Result map[string]interface{}
 
 // Token - access and refresh tokens.
 //
 // See: https://learn.microsoft.com/en-us/linkedin/shared/authentication/authorization-code-flow?toc=%2Flinkedin%2Fmarketing%2Ftoc.json&bc=%2Flinkedin%2Fbreadcrumb%2Ftoc.json&view=li-lms-2024-04&tabs=HTTPS1#step-3-exchange-authorization-code-for-an-access-token
+//
+// ExpiresAt and RefreshTokenExpiresAt are not sent by LinkedIn; they are
+// computed from ExpiresIn and RefreshTokenExpiresIn when the token is received.
 type Token struct {
-	AccessToken           string `json:"access_token"`
-	ExpiresIn             int64  `json:"expires_in"`
-	RefreshToken          string `json:"refresh_token"`
-	RefreshTokenExpiresIn int64  `json:"refresh_token_expires_in"`
-	Scope                 string `json:"scope"`
+	AccessToken           string    `json:"access_token"`
+	ExpiresIn             int64     `json:"expires_in"`
+	RefreshToken          string    `json:"refresh_token"`
+	RefreshTokenExpiresIn int64     `json:"refresh_token_expires_in"`
+	Scope                 string    `json:"scope"`
+	IDToken               string    `json:"id_token"` // with `openid` scope, see IDTokenVerifier
+	ExpiresAt             time.Time `json:"expires_at"`
+	RefreshTokenExpiresAt time.Time `json:"refresh_token_expires_at"`
+}
+
+// setExpiry computes the absolute expiry timestamps relative to now.
+func (t *Token) setExpiry(now time.Time) {
+	if t.ExpiresIn > 0 {
+		t.ExpiresAt = now.Add(time.Duration(t.ExpiresIn) * time.Second)
+	}
+	if t.RefreshTokenExpiresIn > 0 {
+		t.RefreshTokenExpiresAt = now.Add(time.Duration(t.RefreshTokenExpiresIn) * time.Second)
+	}
 }
 
 // TokenData - access and refresh token data after token inspection.
@@ -27,3 +48,51 @@ type TokenData struct {
 	Scope        string `json:"scope"`
 	AuthType     string `json:"auth_type"`
 }
+
+// TokenStatus - status of an introspected token
+type TokenStatus string
+
+// Token statuses returned by token introspection
+const (
+	TokenActive  TokenStatus = "active"
+	TokenExpired TokenStatus = "expired"
+	TokenRevoked TokenStatus = "revoked"
+)
+
+// TokenStatus returns the typed status of the token.
+func (td TokenData) TokenStatus() TokenStatus {
+	return TokenStatus(strings.ToLower(td.Status))
+}
+
+// Scopes parses the scopes granted to the token.
+func (td TokenData) Scopes() ScopeSet {
+	return ParseScopes(td.Scope)
+}
+
+// HasScope reports whether scope was granted to the token.
+func (td TokenData) HasScope(scope Scope) bool {
+	return td.Scopes().HasScope(scope)
+}
+
+// ExpiresTime returns ExpiresAt as time.Time, or the zero time if it is not set.
+func (td TokenData) ExpiresTime() time.Time {
+	return unixTime(td.ExpiresAt)
+}
+
+// AuthorizedTime returns AuthorizedAt as time.Time, or the zero time if it is not set.
+func (td TokenData) AuthorizedTime() time.Time {
+	return unixTime(td.AuthorizedAt)
+}
+
+// CreatedTime returns CreatedAt as time.Time, or the zero time if it is not set.
+func (td TokenData) CreatedTime() time.Time {
+	return unixTime(td.CreatedAt)
+}
+
+// unixTime converts unix seconds to time.Time, zero stays the zero time.
+func unixTime(seconds int64) time.Time {
+	if seconds == 0 {
+		return time.Time{}
+	}
+	return time.Unix(seconds, 0)
+}
@@ -0,0 +1,205 @@
+package linkedin
+
+import (
+	"context"
+	"errors"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// RetryPolicy controls how failed requests to LinkedIn API are retried.
+//
+// A request is retried when LinkedIn responds with one of the
+// RetryableStatusCodes or, if RetryNetworkErrors is set, when the server
+// cannot be reached. The delay between attempts grows exponentially from
+// BaseDelay up to MaxDelay, unless LinkedIn sends a `Retry-After` header.
+type RetryPolicy struct {
+	MaxAttempts          int            // total number of attempts including the first one
+	BaseDelay            time.Duration  // delay before the first retry
+	MaxDelay             time.Duration  // upper limit of the delay between attempts
+	Jitter               float64        // fraction of the delay to randomize, between 0 and 1
+	RetryableStatusCodes []int          // e.g. 429, 502, 503, 504
+	RetryNetworkErrors   bool           // retry when LinkedIn server cannot be reached
+	Methods              []RestLiMethod // Rest.li methods to retry, idempotent methods by default
+}
+
+// IdempotentMethods - Rest.li methods which can be safely retried
+var IdempotentMethods = []RestLiMethod{
+	Get,
+	BatchGet,
+	GetAll,
+	Finder,
+	BatchFinder,
+	Update,
+	BatchUpdate,
+	Delete,
+	BatchDelete,
+}
+
+// DefaultRetryPolicy returns a retry policy for throttled and transient server errors.
+func DefaultRetryPolicy() *RetryPolicy {
+	return &RetryPolicy{
+		MaxAttempts: 4,
+		BaseDelay:   500 * time.Millisecond,
+		MaxDelay:    30 * time.Second,
+		Jitter:      0.2,
+		RetryableStatusCodes: []int{
+			http.StatusTooManyRequests,
+			http.StatusBadGateway,
+			http.StatusServiceUnavailable,
+			http.StatusGatewayTimeout,
+		},
+		RetryNetworkErrors: true,
+		Methods:            IdempotentMethods,
+	}
+}
+
+// SetRetryPolicy sets the retry policy of the session.
+// A nil policy disables retries.
+func (session *Session) SetRetryPolicy(policy *RetryPolicy) {
+	session.retryPolicy = policy
+}
+
+// allows reports whether requests with the given Rest.li method can be retried.
+func (p *RetryPolicy) allows(method RestLiMethod) bool {
+	methods := p.Methods
+	if methods == nil {
+		methods = IdempotentMethods
+	}
+	for _, m := range methods {
+		if m == method {
+			return true
+		}
+	}
+	return false
+}
+
+// retryable reports whether the result of an attempt should be retried.
+func (p *RetryPolicy) retryable(err error) bool {
+	if err == nil {
+		return false
+	}
+	if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) ||
+		errors.Is(err, ErrRateLimitExceeded) {
+		return false
+	}
+
+	apiErr, ok := AsAPIError(err)
+	if !ok {
+		return p.RetryNetworkErrors
+	}
+	for _, code := range p.RetryableStatusCodes {
+		if apiErr.StatusCode == code {
+			return true
+		}
+	}
+	return false
+}
+
+// delay returns the delay before the given retry attempt (starting at 1).
+func (p *RetryPolicy) delay(attempt int, response *Response) time.Duration {
+	if response != nil {
+		if d, ok := parseRetryAfter(response.Header.Get("Retry-After"), time.Now()); ok {
+			if p.MaxDelay > 0 && d > p.MaxDelay {
+				return p.MaxDelay
+			}
+			return d
+		}
+	}
+
+	d := p.BaseDelay
+	for i := 1; i < attempt; i++ {
+		d *= 2
+		if p.MaxDelay > 0 && d >= p.MaxDelay {
+			d = p.MaxDelay
+			break
+		}
+	}
+
+	if p.Jitter > 0 {
+		d += time.Duration((rand.Float64()*2 - 1) * p.Jitter * float64(d))
+	}
+	if d < 0 {
+		d = 0
+	}
+	return d
+}
+
+// parseRetryAfter parses the `Retry-After` header given in seconds or as HTTP date.
+func parseRetryAfter(value string, now time.Time) (time.Duration, bool) {
+	if value == "" {
+		return 0, false
+	}
+
+	if seconds, err := strconv.Atoi(value); err == nil {
+		if seconds < 0 {
+			return 0, false
+		}
+		return time.Duration(seconds) * time.Second, true
+	}
+
+	if date, err := http.ParseTime(value); err == nil {
+		d := date.Sub(now)
+		if d < 0 {
+			d = 0
+		}
+		return d, true
+	}
+
+	return 0, false
+}
+
+// doWithRetry sends an HTTP request with accessToken and retries it according to the retry policy.
+func (session *Session) doWithRetry(method RestLiMethod, request *http.Request, accessToken string) (*Response, error) {
+	policy := session.retryPolicy
+	if policy == nil || policy.MaxAttempts < 2 || !policy.allows(method) {
+		return session.doLimited(request, accessToken)
+	}
+
+	ctx := request.Context()
+	for attempt := 1; ; attempt++ {
+		response, err := session.doLimited(request, accessToken)
+		if attempt >= policy.MaxAttempts || !policy.retryable(err) {
+			return response, err
+		}
+
+		// do not wait beyond the context deadline
+		wait := policy.delay(attempt, response)
+		if deadline, ok := ctx.Deadline(); ok && time.Until(deadline) < wait {
+			return response, err
+		}
+
+		timer := time.NewTimer(wait)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return response, err
+		case <-timer.C:
+		}
+
+		// rewind the request body for the next attempt
+		next := request.Clone(ctx)
+		if request.GetBody != nil {
+			body, bodyErr := request.GetBody()
+			if bodyErr != nil {
+				return response, err
+			}
+			next.Body = body
+		}
+		request = next
+	}
+}
+
+// doLimited waits for the rate limiter and sends an HTTP request with accessToken.
+func (session *Session) doLimited(request *http.Request, accessToken string) (*Response, error) {
+	if session.rateLimiter != nil {
+		err := session.rateLimiter.Wait(request.Context(), session.rateLimitKey(request.URL, accessToken))
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return session.do(request)
+}
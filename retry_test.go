@@ -0,0 +1,94 @@
+package linkedin
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// newRetryTestSession returns a session pointing to a server which
+// responds with 429 until the given number of calls is reached
+func newRetryTestSession(t *testing.T, failures int32, calls *int32) *Session {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(calls, 1) <= failures {
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(http.StatusTooManyRequests)
+			_, _ = w.Write([]byte(`{"status":429,"code":"TOO_MANY_REQUESTS","message":"Resource level throttle limit reached"}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"id":"urn:li:organization:1"}`))
+	}))
+	t.Cleanup(server.Close)
+
+	session := New("id", "secret").Session("token")
+	session.BaseURL = server.URL
+	policy := DefaultRetryPolicy()
+	policy.BaseDelay = time.Millisecond
+	session.SetRetryPolicy(policy)
+	return session
+}
+
+// TestRetryThrottled tests retrying throttled GET requests
+func TestRetryThrottled(t *testing.T) {
+	var calls int32
+	session := newRetryTestSession(t, 2, &calls)
+
+	response, err := session.Do(context.Background(), Get, "/organizations/1", nil, nil)
+	if err != nil {
+		t.Fatalf("Do() error = %v; want nil", err)
+	}
+	if response.StatusCode != http.StatusOK || calls != 3 {
+		t.Errorf("status = %d, calls = %d; want 200, 3", response.StatusCode, calls)
+	}
+}
+
+// TestRetryExhausted tests returning the last error after MaxAttempts
+func TestRetryExhausted(t *testing.T) {
+	var calls int32
+	session := newRetryTestSession(t, 10, &calls)
+
+	_, err := session.Do(context.Background(), Finder, "/posts", Params{"q": "author"}, nil)
+	if !IsRateLimited(err) {
+		t.Fatalf("Do() error = %v; want rate limited", err)
+	}
+	if calls != 4 {
+		t.Errorf("calls = %d; want 4", calls)
+	}
+}
+
+// TestRetryNonIdempotent tests that CREATE requests are not retried by default
+func TestRetryNonIdempotent(t *testing.T) {
+	var calls int32
+	session := newRetryTestSession(t, 1, &calls)
+
+	_, err := session.Do(context.Background(), Create, "/posts", nil, Params{"commentary": "Hello"})
+	if !IsRateLimited(err) || calls != 1 {
+		t.Errorf("error = %v, calls = %d; want rate limited, 1", err, calls)
+	}
+}
+
+// TestParseRetryAfter tests parsing of the Retry-After header
+func TestParseRetryAfter(t *testing.T) {
+	now := time.Date(2024, 5, 1, 10, 0, 0, 0, time.UTC)
+	tests := []struct {
+		value string
+		want  time.Duration
+		ok    bool
+	}{
+		{"", 0, false},
+		{"5", 5 * time.Second, true},
+		{"-1", 0, false},
+		{"Wed, 01 May 2024 10:00:30 GMT", 30 * time.Second, true},
+		{"soon", 0, false},
+	}
+
+	for _, test := range tests {
+		got, ok := parseRetryAfter(test.value, now)
+		if got != test.want || ok != test.ok {
+			t.Errorf("parseRetryAfter(%q) = %v, %v; want %v, %v", test.value, got, ok, test.want, test.ok)
+		}
+	}
+}
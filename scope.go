@@ -0,0 +1,150 @@
+package linkedin
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// Scope - OAuth 2.0 permission granted by a member or an application.
+type Scope string
+
+// LinkedIn OAuth 2.0 scopes
+//
+// See: https://learn.microsoft.com/en-us/linkedin/shared/authentication/getting-access
+const (
+	ScopeOpenID                  Scope = "openid"
+	ScopeProfile                 Scope = "profile"
+	ScopeEmail                   Scope = "email"
+	ScopeMemberSocialWrite       Scope = "w_member_social"
+	ScopeMemberSocialRead        Scope = "r_member_social"
+	ScopeOrganizationSocialRead  Scope = "r_organization_social"
+	ScopeOrganizationSocialWrite Scope = "w_organization_social"
+	ScopeOrganizationAdminRead   Scope = "r_organization_admin"
+	ScopeOrganizationAdmin       Scope = "rw_organization_admin"
+	ScopeAdsRead                 Scope = "r_ads"
+	ScopeAds                     Scope = "rw_ads"
+	ScopeAdsReporting            Scope = "r_ads_reporting"
+	ScopeBasicProfile            Scope = "r_basicprofile"
+)
+
+// ScopeSet - set of scopes
+type ScopeSet map[Scope]struct{}
+
+// ParseScopes parses a space or comma separated list of scopes,
+// e.g. the scope of Token or TokenData.
+func ParseScopes(s string) ScopeSet {
+	set := ScopeSet{}
+	for _, field := range strings.FieldsFunc(s, func(r rune) bool {
+		return r == ' ' || r == ','
+	}) {
+		set[Scope(field)] = struct{}{}
+	}
+	return set
+}
+
+// NewScopeSet returns a set of the given scopes.
+func NewScopeSet(scopes ...Scope) ScopeSet {
+	set := make(ScopeSet, len(scopes))
+	for _, scope := range scopes {
+		set[scope] = struct{}{}
+	}
+	return set
+}
+
+// HasScope reports whether scope is in the set.
+func (s ScopeSet) HasScope(scope Scope) bool {
+	_, ok := s[scope]
+	return ok
+}
+
+// Strings returns the sorted scopes of the set.
+func (s ScopeSet) Strings() []string {
+	scopes := make([]string, 0, len(s))
+	for scope := range s {
+		scopes = append(scopes, string(scope))
+	}
+	sort.Strings(scopes)
+	return scopes
+}
+
+// String returns the sorted scopes separated by spaces.
+func (s ScopeSet) String() string {
+	return strings.Join(s.Strings(), " ")
+}
+
+// Add adds scopes to the set.
+func (s ScopeSet) Add(scopes ...Scope) {
+	for _, scope := range scopes {
+		s[scope] = struct{}{}
+	}
+}
+
+// Missing returns the scopes of required which are not in the set.
+func (s ScopeSet) Missing(required ...Scope) []Scope {
+	var missing []Scope
+	for _, scope := range required {
+		if !s.HasScope(scope) {
+			missing = append(missing, scope)
+		}
+	}
+	return missing
+}
+
+// Scopes parses the scopes granted to the token.
+func (t Token) Scopes() ScopeSet {
+	return ParseScopes(t.Scope)
+}
+
+// ScopeError is returned when a request requires scopes which were not granted.
+type ScopeError struct {
+	Missing []Scope  // required scopes which were not granted
+	Granted ScopeSet // scopes granted to the access token
+}
+
+// Error implements the error interface.
+func (e *ScopeError) Error() string {
+	missing := make([]string, len(e.Missing))
+	for i, scope := range e.Missing {
+		missing[i] = string(scope)
+	}
+	return fmt.Sprintf("linkedIn: access token is missing required scopes %s (granted: %s)",
+		strings.Join(missing, " "), e.Granted)
+}
+
+// SetGrantedScopes sets the scopes granted to the access token of the session,
+// e.g. from Token.Scopes or TokenData.Scopes.
+//
+// If not set, the scopes of the token source are used, if any.
+func (session *Session) SetGrantedScopes(scopes ScopeSet) {
+	session.grantedScopes = scopes
+}
+
+// GrantedScopes returns the scopes granted to the access token of the session,
+// or nil if they are unknown.
+func (session *Session) GrantedScopes() ScopeSet {
+	if session.grantedScopes != nil {
+		return session.grantedScopes
+	}
+	if session.tokenSource != nil {
+		if token, err := session.tokenSource.Token(); err == nil && token.Scope != "" {
+			return token.Scopes()
+		}
+	}
+	return nil
+}
+
+// RequireScopes returns a *ScopeError if any of the required scopes was not granted,
+// so that a request can fail before it is sent. It returns nil if the granted
+// scopes are unknown.
+func (session *Session) RequireScopes(required ...Scope) error {
+	granted := session.GrantedScopes()
+	if granted == nil {
+		return nil
+	}
+
+	if missing := granted.Missing(required...); len(missing) > 0 {
+		return &ScopeError{Missing: missing, Granted: granted}
+	}
+	return nil
+}
@@ -0,0 +1,43 @@
+package linkedin
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+// TestParseScopes tests parsing space and comma separated scopes
+func TestParseScopes(t *testing.T) {
+	token := Token{Scope: "r_organization_social,w_organization_social openid"}
+	scopes := token.Scopes()
+
+	want := []string{"openid", "r_organization_social", "w_organization_social"}
+	if !reflect.DeepEqual(scopes.Strings(), want) {
+		t.Errorf("Strings() = %v; want %v", scopes.Strings(), want)
+	}
+	if !scopes.HasScope(ScopeOrganizationSocialWrite) || scopes.HasScope(ScopeAdsReporting) {
+		t.Errorf("HasScope() unexpected result for %v", scopes)
+	}
+}
+
+// TestRequireScopes tests failing fast when required scopes are missing
+func TestRequireScopes(t *testing.T) {
+	session := New("client", "secret").Session("token")
+	if err := session.RequireScopes(ScopeAdsReporting); err != nil {
+		t.Errorf("RequireScopes() with unknown scopes error = %v; want nil", err)
+	}
+
+	session.SetGrantedScopes(NewScopeSet(ScopeOpenID, ScopeProfile))
+	err := session.RequireScopes(ScopeOpenID, ScopeOrganizationSocialWrite)
+
+	var scopeErr *ScopeError
+	if !errors.As(err, &scopeErr) || !reflect.DeepEqual(scopeErr.Missing, []Scope{ScopeOrganizationSocialWrite}) {
+		t.Errorf("RequireScopes() error = %v; want missing w_organization_social", err)
+	}
+
+	// fails before the request is sent
+	session.SetGrantedScopes(NewScopeSet(ScopeOrganizationSocialRead))
+	if _, err := session.UserInfo(); !errors.As(err, &scopeErr) {
+		t.Errorf("UserInfo() error = %v; want *ScopeError", err)
+	}
+}
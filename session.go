@@ -9,6 +9,7 @@ import (
 	"net/http"
 	"net/url"
 	"strings"
+	"time"
 )
 
 // Session holds a LinkedIn session with an access token.
@@ -21,6 +22,11 @@ type Session struct {
 	LinkedInVersion        string          // e.g. 202404
 	useAuthorizationHeader bool            // pass accessToken in headers
 	context                context.Context // session context
+	retryPolicy            *RetryPolicy    // retry failed requests, disabled if nil
+	rateLimiter            RateLimiter     // client-side rate limiter, disabled if nil
+	tokenSource            TokenSource     // source of access tokens, overrides accessToken
+	grantedScopes          ScopeSet        // scopes granted to the access token, unknown if nil
+	memberID               string          // stable member identity for rate limits, optional
 }
 
 // HTTPClient is an interface to send http request.
@@ -34,10 +40,14 @@ type HTTPClient interface {
 // Params to construct the request payload.
 type Params map[string]interface{}
 
-// default LinkedIn session
-var (
-	defaultSession = &Session{}
-)
+// clone returns a shallow copy of params.
+func (params Params) clone() Params {
+	c := make(Params, len(params)+1)
+	for key, value := range params {
+		c[key] = value
+	}
+	return c
+}
 
 // App returns associated App.
 func (session *Session) App() *App {
@@ -45,8 +55,10 @@ func (session *Session) App() *App {
 }
 
 // AccessToken returns current access token.
+// If a token source is set, the access token is taken from it.
 func (session *Session) AccessToken() string {
-	return session.accessToken
+	accessToken, _ := session.currentAccessToken(session.Context())
+	return accessToken
 }
 
 // SetAccessToken sets a new access token.
@@ -63,27 +75,187 @@ func (session *Session) UseAuthorizationHeader() {
 
 // Get sends a GET request to LinkedIn API and returns the response.
 func (session *Session) Get(uri string) (response *http.Response, data []byte, err error) {
-	// uri must start with `/`
-	if !strings.HasPrefix(uri, "/") {
-		uri = "/" + uri
+	return session.send(Get, uri, nil)
+}
+
+// Post sends a CREATE request with a JSON body to LinkedIn API and returns the response.
+//
+// Use GetCreatedEntityID to read the ID of the newly created entity.
+func (session *Session) Post(uri string, body interface{}) (response *http.Response, data []byte, err error) {
+	return session.send(Create, uri, body)
+}
+
+// Put sends an UPDATE request with a JSON body to LinkedIn API and returns the response.
+func (session *Session) Put(uri string, body interface{}) (response *http.Response, data []byte, err error) {
+	return session.send(Update, uri, body)
+}
+
+// PartialUpdate sends a PARTIAL_UPDATE request with a JSON body to LinkedIn API and returns the response.
+//
+// See: https://linkedin.github.io/rest.li/spec/protocol#partial-update
+func (session *Session) PartialUpdate(uri string, body interface{}) (response *http.Response, data []byte, err error) {
+	return session.send(PartialUpdate, uri, body)
+}
+
+// Action sends an ACTION request with a JSON body to LinkedIn API and returns the response.
+func (session *Session) Action(uri string, body interface{}) (response *http.Response, data []byte, err error) {
+	return session.send(Action, uri, body)
+}
+
+// Delete sends a DELETE request to LinkedIn API and returns the response.
+func (session *Session) Delete(uri string) (response *http.Response, data []byte, err error) {
+	return session.send(Delete, uri, nil)
+}
+
+// send wraps Session.Do for the helpers returning the raw HTTP response.
+func (session *Session) send(method RestLiMethod, uri string, body interface{}) (response *http.Response, data []byte, err error) {
+	res, err := session.Do(session.Context(), method, uri, nil, body)
+	if res != nil {
+		response = res.HTTPResponse
+		data = res.Body
 	}
-	url := session.BaseURL + uri
+	return
+}
 
-	// create a new HTTP request
-	request, err := http.NewRequest("GET", url, nil)
+// Do sends a request with the given Rest.li method to the versioned LinkedIn API
+// and returns the response.
+//
+// path is relative to Session.BaseURL, or an absolute URL such as a resolved
+// pagination link, and may already contain a query string. The access token
+// is only sent to the host of Session.BaseURL or VersionedBaseURL.
+// query is encoded in Rest.li protocol 2.0.0 format and appended to the query string of path.
+// body is sent as `application/x-www-form-urlencoded` if it is of type url.Values,
+// as it is if it is of type []byte, and encoded as JSON otherwise.
+// If ctx is nil, the session context is used.
+//
+// For non-2xx responses, the response is returned together with an *APIError.
+func (session *Session) Do(ctx context.Context, method RestLiMethod, path string, query Params, body interface{}) (*Response, error) {
+	httpMethod, ok := RestLiMethodToHTTPMethodMap[method]
+	if !ok {
+		return nil, fmt.Errorf("linkedIn: unsupported Rest.li method %s", method)
+	}
+
+	url := joinURL(session.BaseURL, path)
+	q, err := query.Encode()
+	if err != nil {
+		return nil, err
+	}
+	if q != "" {
+		if strings.Contains(url, "?") {
+			url += "&" + q
+		} else {
+			url += "?" + q
+		}
+	}
+
+	request, err := session.newRequest(ctx, httpMethod, url, body)
 	if err != nil {
-		err = fmt.Errorf("linkedIn: cannot create new request; %w", err)
-		return nil, nil, err
+		return nil, err
 	}
 
 	// set headers
-	request.Header.Set(string(ContentType), string(JSON))
 	request.Header.Set(string(RestLiProtocolVersion), "2.0.0")
 	request.Header.Set(string(LinkedInVersion), session.LinkedInVersion)
+	if method != Get {
+		request.Header.Set(string(RestLiMethodHeader), string(method))
+	}
+	accessToken, err := session.currentAccessToken(request.Context())
+	if err != nil {
+		return nil, err
+	}
+	if session.useAuthorizationHeader && session.trustedURL(request.URL) {
+		request.Header.Set(string(Authorization), "Bearer "+accessToken)
+	}
 
 	// send the request
-	response, data, err = session.sendRequest(request)
-	return
+	response, err := session.doWithRetry(method, request, accessToken)
+	if IsUnauthorized(err) {
+		if retried, ok, retryErr := session.retryUnauthorized(method, request, accessToken); ok {
+			return retried, retryErr
+		}
+	}
+	return response, err
+}
+
+// joinURL appends path to baseURL unless path is an absolute URL.
+func joinURL(baseURL, path string) string {
+	if strings.HasPrefix(path, "http://") || strings.HasPrefix(path, "https://") {
+		return path
+	}
+
+	// path must start with `/`
+	if !strings.HasPrefix(path, "/") {
+		path = "/" + path
+	}
+	return baseURL + path
+}
+
+// trustedURL reports whether the access token may be sent to u,
+// i.e. u points to the host of the session base URL or VersionedBaseURL.
+func (session *Session) trustedURL(u *url.URL) bool {
+	if sameOrigin(u, versionedBaseURL()) {
+		return true
+	}
+	base, err := url.Parse(session.BaseURL)
+	return err == nil && base.IsAbs() && sameOrigin(u, base)
+}
+
+// postForm sends a form-urlencoded POST request to the LinkedIn OAuth endpoint.
+func (session *Session) postForm(uri string, data url.Values) (*Response, error) {
+	oauthBaseURL := OauthBaseURL
+	if session.app != nil {
+		oauthBaseURL = session.app.oauthBaseURL()
+	}
+
+	request, err := session.newRequest(session.Context(), POST, oauthBaseURL+uri, data)
+	if err != nil {
+		return nil, err
+	}
+
+	return session.do(request)
+}
+
+// newRequest creates a new HTTP request and encodes body based on its type.
+func (session *Session) newRequest(ctx context.Context, method Method, rawURL string, body interface{}) (*http.Request, error) {
+	if ctx == nil {
+		ctx = session.Context()
+	}
+
+	var requestBody io.Reader
+	contentType := JSON
+	switch b := body.(type) {
+	case nil:
+	case url.Values:
+		requestBody = strings.NewReader(b.Encode())
+		contentType = URLEncoded
+	case []byte:
+		requestBody = bytes.NewReader(b)
+	default:
+		payload, err := json.Marshal(b)
+		if err != nil {
+			return nil, fmt.Errorf("linkedIn: cannot encode request body; %w", err)
+		}
+		requestBody = bytes.NewReader(payload)
+	}
+
+	// create a new HTTP request
+	request, err := http.NewRequestWithContext(ctx, string(method), rawURL, requestBody)
+	if err != nil {
+		return nil, fmt.Errorf("linkedIn: cannot create new request; %w", err)
+	}
+	request.Header.Set(string(ContentType), string(contentType))
+
+	return request, nil
+}
+
+// GetCreatedEntityID returns the ID of the entity created by a CREATE request.
+//
+// LinkedIn returns it in the `X-RestLi-Id` response header.
+func GetCreatedEntityID(response *http.Response) string {
+	if response == nil {
+		return ""
+	}
+	return response.Header.Get(string(CreatedEntityID))
 }
 
 // sendAuthRequest sends an auth request to LinkedIn and returns new tokens.
@@ -108,11 +280,20 @@ func (session *Session) sendAuthRequest(uri string, params Params) (Token, error
 		return Token{}, fmt.Errorf("linkedIn: client_id is required to receive new tokens")
 	}
 
-	if params["client_secret"] == nil {
-		return Token{}, fmt.Errorf("linkedIn: client_secret is missing")
+	// PKCE: public clients redeem the auth code with code_verifier instead of client_secret
+	codeVerifier := ""
+	if grantType == "authorization_code" && params["code_verifier"] != nil {
+		codeVerifier = params["code_verifier"].(string)
+	}
+
+	clientSecret := ""
+	if params["client_secret"] != nil {
+		clientSecret = params["client_secret"].(string)
 	}
-	clientSecret := params["client_secret"].(string)
-	if clientSecret == "" {
+	if clientSecret == "" && codeVerifier == "" {
+		if params["client_secret"] == nil {
+			return Token{}, fmt.Errorf("linkedIn: client_secret is missing")
+		}
 		return Token{}, fmt.Errorf("linkedIn: client_secret is required to receive new tokens")
 	}
 
@@ -147,48 +328,37 @@ func (session *Session) sendAuthRequest(uri string, params Params) (Token, error
 		}
 	}
 
-	oauthURL := OauthBaseURL + uri
-
 	// data to be sent in the body (x-www-form-urlencoded)
 	data := url.Values{}
 	data.Set("grant_type", grantType)
 	data.Add("client_id", clientID)
-	data.Add("client_secret", clientSecret)
+	if clientSecret != "" {
+		data.Add("client_secret", clientSecret)
+	}
 	if grantType == "authorization_code" {
 		data.Add("redirect_uri", redirectURI)
 		data.Add("code", code)
+		if codeVerifier != "" {
+			data.Add("code_verifier", codeVerifier)
+		}
 	}
 	if grantType == "refresh_token" {
 		data.Add("refresh_token", refToken)
 	}
 
-	// encode data into appropriate format
-	requestBody := bytes.NewBufferString(data.Encode())
-
-	// create a new HTTP request
-	request, err := http.NewRequest("POST", oauthURL, requestBody)
-	if err != nil {
-		return Token{}, err
-	}
-
-	// set headers
-	request.Header.Set(string(ContentType), string(URLEncoded))
-
 	// send the request
-	response, responseData, err := session.sendRequest(request)
+	response, err := session.postForm(uri, data)
 	if err != nil {
 		return Token{}, err
 	}
-	if response.StatusCode != http.StatusOK {
-		return Token{}, fmt.Errorf("linkedIn: failed to receive tokens with status %d", response.StatusCode)
-	}
 
 	// parse the response body
 	var token Token
-	err = json.Unmarshal(responseData, &token)
+	err = response.Decode(&token)
 	if err != nil {
 		return Token{}, err
 	}
+	token.setExpiry(time.Now())
 
 	return token, nil
 }
@@ -224,38 +394,21 @@ func (session *Session) introspect(uri string, params Params) (TokenData, error)
 		return TokenData{}, fmt.Errorf("linkedIn: token is required for introspection")
 	}
 
-	oauthURL := OauthBaseURL + uri
-
 	// data to be sent in the body (x-www-form-urlencoded)
 	data := url.Values{}
 	data.Set("client_id", session.App().ClientID)
 	data.Add("client_secret", session.App().ClientSecret)
 	data.Add("token", token)
 
-	// encode data into appropriate format
-	requestBody := bytes.NewBufferString(data.Encode())
-
-	// create a new HTTP request
-	request, err := http.NewRequest("POST", oauthURL, requestBody)
-	if err != nil {
-		return TokenData{}, err
-	}
-
-	// set headers
-	request.Header.Set(string(ContentType), string(URLEncoded))
-
 	// send the request
-	response, responseData, err := session.sendRequest(request)
+	response, err := session.postForm(uri, data)
 	if err != nil {
 		return TokenData{}, err
 	}
-	if response.StatusCode != http.StatusOK {
-		return TokenData{}, fmt.Errorf("linkedIn: failed to introspect token with status %d", response.StatusCode)
-	}
 
 	// parse the response body
 	var tokenData TokenData
-	err = json.Unmarshal(responseData, &tokenData)
+	err = response.Decode(&tokenData)
 	if err != nil {
 		return TokenData{}, err
 	}
@@ -263,24 +416,40 @@ func (session *Session) introspect(uri string, params Params) (TokenData, error)
 	return tokenData, nil
 }
 
-// sendRequest sends an API request and returns the response.
-func (session *Session) sendRequest(request *http.Request) (response *http.Response, data []byte, err error) {
-	if session.context != nil {
-		request = request.WithContext(session.context)
+// Revoke revokes the given access token. The member has to authorize
+// the application again to obtain a new token.
+//
+// See: https://learn.microsoft.com/en-us/linkedin/shared/authentication/token-revocation
+func (session *Session) Revoke(token string) error {
+	token = strings.TrimSpace(token)
+	if token == "" {
+		err := fmt.Errorf("linkedIn: token is empty")
+		return err
 	}
 
-	if session.useAuthorizationHeader {
-		request.Header.Set(string(Authorization), "Bearer "+session.accessToken)
-	}
+	// data to be sent in the body (x-www-form-urlencoded)
+	data := url.Values{}
+	data.Set("client_id", session.App().ClientID)
+	data.Add("client_secret", session.App().ClientSecret)
+	data.Add("token", token)
+
+	// send the request
+	_, err := session.postForm("/revoke", data)
+	return err
+}
 
+// do sends an HTTP request and returns the response.
+// Non-2xx responses are returned together with an *APIError.
+func (session *Session) do(request *http.Request) (*Response, error) {
+	var response *http.Response
+	var err error
 	if session.HTTPClient == nil {
 		response, err = http.DefaultClient.Do(request)
 	} else {
 		response, err = session.HTTPClient.Do(request)
 	}
 	if err != nil {
-		err = fmt.Errorf("linkedIn: cannot reach linkedIn server; %w", err)
-		return
+		return nil, fmt.Errorf("linkedIn: cannot reach linkedIn server; %w", err)
 	}
 
 	buf := &bytes.Buffer{}
@@ -290,10 +459,11 @@ func (session *Session) sendRequest(request *http.Request) (response *http.Respo
 		err = fmt.Errorf("linkedIn: cannot read linkedIn response; %w", err)
 	} else if closeErr != nil {
 		err = fmt.Errorf("linkedIn: error closing response body; %w", closeErr)
+	} else if response.StatusCode < 200 || response.StatusCode > 299 {
+		err = newAPIError(response, buf.Bytes())
 	}
 
-	data = buf.Bytes()
-	return
+	return newResponse(response, buf.Bytes()), err
 }
 
 // Context returns the session's context.
@@ -0,0 +1,179 @@
+package linkedin
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+)
+
+// TestIntrospectAndRevoke tests typed introspection results and token revocation
+func TestIntrospectAndRevoke(t *testing.T) {
+	revoked := false
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.FormValue("client_id") != "client" || r.FormValue("client_secret") != "secret" || r.FormValue("token") != "access" {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		switch r.URL.Path {
+		case "/oauth/v2/introspectToken":
+			status := "active"
+			if revoked {
+				status = "revoked"
+			}
+			fmt.Fprintf(w, `{"active":%v,"client_id":"client","authorized_at":1700000000,"created_at":1700000000,"status":%q,"expires_at":1705184000,"scope":"r_organization_social,w_organization_social","auth_type":"3L"}`, !revoked, status)
+		case "/oauth/v2/revoke":
+			revoked = true
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	app := New("client", "secret")
+	app.OAuthBaseURL = server.URL + "/oauth/v2"
+	session := app.Session("access")
+
+	tokenData, err := session.Introspect("access")
+	if err != nil {
+		t.Fatalf("Introspect() error = %v", err)
+	}
+	if tokenData.TokenStatus() != TokenActive || !tokenData.HasScope("w_organization_social") || tokenData.HasScope("r_ads") {
+		t.Errorf("unexpected token data %+v", tokenData)
+	}
+	if !tokenData.ExpiresTime().Equal(time.Unix(1705184000, 0)) {
+		t.Errorf("ExpiresTime() = %v; want %v", tokenData.ExpiresTime(), time.Unix(1705184000, 0))
+	}
+
+	if err := session.Revoke("access"); err != nil {
+		t.Fatalf("Revoke() error = %v", err)
+	}
+	tokenData, err = session.Introspect("access")
+	if err != nil || tokenData.TokenStatus() != TokenRevoked {
+		t.Errorf("Introspect() after Revoke() = %+v, %v; want revoked", tokenData, err)
+	}
+}
+
+// TestSessionVerbs tests the HTTP method, Rest.li method header and body of the verb helpers
+func TestSessionVerbs(t *testing.T) {
+	type request struct {
+		method       string
+		restLiMethod string
+		path         string
+		body         map[string]interface{}
+	}
+	var got request
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		got = request{method: r.Method, restLiMethod: r.Header.Get(string(RestLiMethodHeader)), path: r.URL.Path}
+		data, _ := io.ReadAll(r.Body)
+		if len(data) > 0 {
+			if r.Header.Get(string(ContentType)) != string(JSON) {
+				t.Errorf("%s: Content-Type = %s; want %s", r.Method, r.Header.Get(string(ContentType)), JSON)
+			}
+			_ = json.Unmarshal(data, &got.body)
+		}
+		w.Header().Set(string(CreatedEntityID), "urn:li:share:1")
+		w.WriteHeader(http.StatusCreated)
+	}))
+	defer server.Close()
+
+	session := New("client", "secret").Session("token")
+	session.BaseURL = server.URL
+	body := map[string]interface{}{"commentary": "Hello"}
+
+	tests := []struct {
+		name         string
+		send         func() (*http.Response, []byte, error)
+		method       string
+		restLiMethod RestLiMethod
+		hasBody      bool
+	}{
+		{"Get", func() (*http.Response, []byte, error) { return session.Get("/posts") }, http.MethodGet, "", false},
+		{"Post", func() (*http.Response, []byte, error) { return session.Post("/posts", body) }, http.MethodPost, Create, true},
+		{"Put", func() (*http.Response, []byte, error) { return session.Put("/posts", body) }, http.MethodPut, Update, true},
+		{"PartialUpdate", func() (*http.Response, []byte, error) { return session.PartialUpdate("/posts", body) }, http.MethodPost, PartialUpdate, true},
+		{"Action", func() (*http.Response, []byte, error) { return session.Action("/posts", body) }, http.MethodPost, Action, true},
+		{"Delete", func() (*http.Response, []byte, error) { return session.Delete("/posts") }, http.MethodDelete, Delete, false},
+	}
+
+	for _, test := range tests {
+		got = request{}
+		response, _, err := test.send()
+		if err != nil {
+			t.Errorf("%s() error = %v", test.name, err)
+			continue
+		}
+		if got.method != test.method || got.restLiMethod != string(test.restLiMethod) || got.path != "/posts" {
+			t.Errorf("%s() sent %s %s with X-RestLi-Method %q; want %s /posts with %q", test.name, got.method, got.path, got.restLiMethod, test.method, test.restLiMethod)
+		}
+		if test.hasBody != (got.body["commentary"] == "Hello") {
+			t.Errorf("%s() body = %v; want body %v", test.name, got.body, test.hasBody)
+		}
+		if id := GetCreatedEntityID(response); id != "urn:li:share:1" {
+			t.Errorf("%s() GetCreatedEntityID() = %q; want urn:li:share:1", test.name, id)
+		}
+	}
+}
+
+// TestSessionDo tests the query string, request body and response of Session.Do
+func TestSessionDo(t *testing.T) {
+	type request struct {
+		rawQuery    string
+		contentType string
+		body        string
+	}
+	var got request
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		data, _ := io.ReadAll(r.Body)
+		got = request{rawQuery: r.URL.RawQuery, contentType: r.Header.Get(string(ContentType)), body: string(data)}
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	session := New("client", "secret").Session("token")
+	session.BaseURL = server.URL
+	ctx := context.Background()
+
+	tests := []struct {
+		name   string
+		method RestLiMethod
+		path   string
+		query  Params
+		body   interface{}
+		want   request
+	}{
+		{"query", Get, "/posts", Params{"author": "urn:li:person:1"}, nil, request{"author=urn%3Ali%3Aperson%3A1", string(JSON), ""}},
+		{"query appended", Get, "/posts?q=author", Params{"count": 10}, nil, request{"q=author&count=10", string(JSON), ""}},
+		{"no query", Get, "/posts?q=author", nil, nil, request{"q=author", string(JSON), ""}},
+		{"url.Values body", Create, "/posts", nil, url.Values{"name": {"a b"}}, request{"", string(URLEncoded), "name=a+b"}},
+		{"[]byte body", Create, "/posts", nil, []byte(`{"raw":true}`), request{"", string(JSON), `{"raw":true}`}},
+		{"JSON body", Create, "/posts", nil, map[string]bool{"raw": true}, request{"", string(JSON), `{"raw":true}`}},
+	}
+
+	for _, test := range tests {
+		got = request{}
+		response, err := session.Do(ctx, test.method, test.path, test.query, test.body)
+		if err != nil {
+			t.Errorf("%s: Do() error = %v", test.name, err)
+			continue
+		}
+		if got != test.want {
+			t.Errorf("%s: Do() sent %+v; want %+v", test.name, got, test.want)
+		}
+		var v map[string]interface{}
+		if err := response.Decode(&v); err == nil || !strings.Contains(err.Error(), "response body is empty") {
+			t.Errorf("%s: Decode() error = %v; want empty body error", test.name, err)
+		}
+	}
+
+	if _, err := session.Do(ctx, RestLiMethod("UNKNOWN"), "/posts", nil, nil); err == nil || !strings.Contains(err.Error(), "unsupported Rest.li method UNKNOWN") {
+		t.Errorf("Do() error = %v; want unsupported Rest.li method", err)
+	}
+}
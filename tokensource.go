@@ -0,0 +1,275 @@
+package linkedin
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// DefaultRefreshBefore - how long before expiry a RefreshingTokenSource refreshes the access token
+const DefaultRefreshBefore = time.Hour
+
+// DefaultRefreshTimeout - timeout of a refresh request of a RefreshingTokenSource
+const DefaultRefreshTimeout = 30 * time.Second
+
+// TokenSource supplies access tokens to a Session.
+//
+// It is compatible in spirit with golang.org/x/oauth2.TokenSource.
+type TokenSource interface {
+	Token() (Token, error)
+}
+
+// RefreshingTokenSource is a TokenSource which refreshes the access token
+// with App.RefreshToken before it expires. It is safe for concurrent use.
+//
+// See: https://learn.microsoft.com/en-us/linkedin/shared/authentication/programmatic-refresh-tokens
+type RefreshingTokenSource struct {
+	RefreshBefore  time.Duration // refresh this long before expiry, DefaultRefreshBefore if 0
+	RefreshTimeout time.Duration // timeout of a refresh request, DefaultRefreshTimeout if 0
+	OnRefresh      func(Token)   // called with every refreshed token, e.g. to persist it
+
+	app      *App
+	mu       sync.Mutex
+	token    Token
+	expiry   time.Time
+	inflight *refreshCall // refresh in progress, nil if none
+	now      func() time.Time
+}
+
+// NewTokenSource returns a TokenSource which refreshes token with app.
+//
+// The access token expires at token.ExpiresAt. If it is not set,
+// the access token is assumed to have been issued just now.
+func NewTokenSource(app *App, token Token) *RefreshingTokenSource {
+	ts := &RefreshingTokenSource{app: app}
+	ts.setToken(token)
+	return ts
+}
+
+// Token returns a valid access token, refreshing it if it expires soon.
+func (ts *RefreshingTokenSource) Token() (Token, error) {
+	return ts.TokenWithContext(context.Background())
+}
+
+// TokenWithContext returns a valid access token, refreshing it if it expires soon.
+//
+// Concurrent callers share one refresh, which is bounded by RefreshTimeout.
+// ctx only cancels waiting for the refresh, not the refresh itself.
+func (ts *RefreshingTokenSource) TokenWithContext(ctx context.Context) (Token, error) {
+	ts.mu.Lock()
+	now := ts.clock()
+	refreshBefore := ts.RefreshBefore
+	if refreshBefore == 0 {
+		refreshBefore = DefaultRefreshBefore
+	}
+
+	token := ts.token
+	if ts.expiry.IsZero() || now.Before(ts.expiry.Add(-refreshBefore)) {
+		ts.mu.Unlock()
+		return token, nil
+	}
+
+	// keep using the access token until it expires if it cannot be refreshed
+	if token.RefreshToken == "" {
+		expired := !now.Before(ts.expiry)
+		ts.mu.Unlock()
+		if expired {
+			return Token{}, fmt.Errorf("linkedIn: access token expired and no refresh token is available")
+		}
+		return token, nil
+	}
+
+	call := ts.startRefreshLocked()
+	ts.mu.Unlock()
+	return call.wait(ctx)
+}
+
+// Refresh refreshes the access token regardless of its expiry.
+func (ts *RefreshingTokenSource) Refresh() (Token, error) {
+	ts.mu.Lock()
+	call := ts.startRefreshLocked()
+	ts.mu.Unlock()
+	return call.wait(context.Background())
+}
+
+// refreshStale refreshes the access token after LinkedIn rejected accessToken,
+// unless another goroutine has already replaced it.
+func (ts *RefreshingTokenSource) refreshStale(ctx context.Context, accessToken string) (Token, error) {
+	ts.mu.Lock()
+	if ts.token.AccessToken != accessToken {
+		token := ts.token
+		ts.mu.Unlock()
+		return token, nil
+	}
+	call := ts.startRefreshLocked()
+	ts.mu.Unlock()
+	return call.wait(ctx)
+}
+
+// refreshCall - a refresh shared by concurrent callers
+type refreshCall struct {
+	done  chan struct{}
+	token Token
+	err   error
+}
+
+// wait waits until the refresh is done or ctx is done.
+func (call *refreshCall) wait(ctx context.Context) (Token, error) {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	select {
+	case <-call.done:
+		return call.token, call.err
+	case <-ctx.Done():
+		return Token{}, fmt.Errorf("linkedIn: cannot refresh access token; %w", ctx.Err())
+	}
+}
+
+// startRefreshLocked starts a refresh unless one is in flight, ts.mu must be held.
+// The refresh runs without holding ts.mu.
+func (ts *RefreshingTokenSource) startRefreshLocked() *refreshCall {
+	if ts.inflight != nil {
+		return ts.inflight
+	}
+
+	call := &refreshCall{done: make(chan struct{})}
+	ts.inflight = call
+	go ts.refresh(call, ts.token)
+	return call
+}
+
+// refresh refreshes previous and completes call.
+func (ts *RefreshingTokenSource) refresh(call *refreshCall, previous Token) {
+	timeout := ts.RefreshTimeout
+	if timeout <= 0 {
+		timeout = DefaultRefreshTimeout
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	var token Token
+	var err error
+	if previous.RefreshToken == "" {
+		err = fmt.Errorf("linkedIn: no refresh token is available")
+	} else {
+		token, err = ts.app.RefreshTokenWithContext(ctx, previous.RefreshToken)
+	}
+
+	// LinkedIn may not rotate the refresh token
+	if err == nil && token.RefreshToken == "" {
+		token.RefreshToken = previous.RefreshToken
+		token.RefreshTokenExpiresIn = previous.RefreshTokenExpiresIn
+		token.RefreshTokenExpiresAt = previous.RefreshTokenExpiresAt
+	}
+
+	ts.mu.Lock()
+	if err == nil {
+		ts.setToken(token)
+		token = ts.token
+	}
+	ts.inflight = nil
+	ts.mu.Unlock()
+
+	if err == nil {
+		ts.notify(token)
+	} else {
+		token = Token{}
+	}
+	call.token, call.err = token, err
+	close(call.done)
+}
+
+// setToken replaces the token and computes its expiry.
+func (ts *RefreshingTokenSource) setToken(token Token) {
+	if token.ExpiresAt.IsZero() {
+		token.setExpiry(ts.clock())
+	}
+	ts.token = token
+	ts.expiry = token.ExpiresAt
+}
+
+// refreshToken returns the current refresh token.
+func (ts *RefreshingTokenSource) refreshToken() string {
+	ts.mu.Lock()
+	defer ts.mu.Unlock()
+	return ts.token.RefreshToken
+}
+
+// notify reports a refreshed token to OnRefresh.
+func (ts *RefreshingTokenSource) notify(token Token) {
+	if ts.OnRefresh != nil {
+		ts.OnRefresh(token)
+	}
+}
+
+// clock returns the current time.
+func (ts *RefreshingTokenSource) clock() time.Time {
+	if ts.now != nil {
+		return ts.now()
+	}
+	return time.Now()
+}
+
+// SetTokenSource sets the source of access tokens of the session.
+//
+// The access token is requested from ts before every request and passed in
+// the Authorization header. If ts is a *RefreshingTokenSource, a request
+// rejected with 401 is retried once with a refreshed token.
+func (session *Session) SetTokenSource(ts TokenSource) {
+	session.tokenSource = ts
+	if ts != nil {
+		session.useAuthorizationHeader = true
+	}
+}
+
+// currentAccessToken returns the access token for the next request.
+// Waiting for a refreshing token source is cancelled when ctx is done.
+func (session *Session) currentAccessToken(ctx context.Context) (string, error) {
+	if session.tokenSource == nil {
+		return session.accessToken, nil
+	}
+
+	var token Token
+	var err error
+	if ts, ok := session.tokenSource.(interface {
+		TokenWithContext(ctx context.Context) (Token, error)
+	}); ok {
+		token, err = ts.TokenWithContext(ctx)
+	} else {
+		token, err = session.tokenSource.Token()
+	}
+	if err != nil {
+		return "", fmt.Errorf("linkedIn: cannot get access token; %w", err)
+	}
+	return token.AccessToken, nil
+}
+
+// retryUnauthorized resends a request rejected with 401 once with a refreshed access token.
+// It returns false if the request was not resent.
+func (session *Session) retryUnauthorized(method RestLiMethod, request *http.Request, accessToken string) (*Response, bool, error) {
+	ts, ok := session.tokenSource.(*RefreshingTokenSource)
+	if !ok || request.Header.Get(string(Authorization)) == "" {
+		return nil, false, nil
+	}
+
+	token, err := ts.refreshStale(request.Context(), accessToken)
+	if err != nil {
+		return nil, false, nil
+	}
+
+	next := request.Clone(request.Context())
+	if request.GetBody != nil {
+		body, err := request.GetBody()
+		if err != nil {
+			return nil, false, nil
+		}
+		next.Body = body
+	}
+	next.Header.Set(string(Authorization), "Bearer "+token.AccessToken)
+
+	response, err := session.doWithRetry(method, next, token.AccessToken)
+	return response, true, err
+}
@@ -0,0 +1,108 @@
+package linkedin
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// newTokenTestApp returns an app whose token endpoint issues access tokens
+// named after the number of refreshes
+func newTokenTestApp(t *testing.T, refreshes *int32) *App {
+	return newTokenEndpointApp(t, nil, func(form url.Values) (int, string) {
+		if form.Get("grant_type") != "refresh_token" {
+			return http.StatusBadRequest, ""
+		}
+		n := atomic.AddInt32(refreshes, 1)
+		return http.StatusOK, fmt.Sprintf(`{"access_token":"access-%d","expires_in":5184000}`, n)
+	})
+}
+
+// TestRefreshingTokenSource tests proactive refresh before expiry
+func TestRefreshingTokenSource(t *testing.T) {
+	var refreshes int32
+	app := newTokenTestApp(t, &refreshes)
+
+	now := time.Now()
+	ts := NewTokenSource(app, Token{AccessToken: "access-0", ExpiresIn: 7200, RefreshToken: "refresh"})
+	ts.now = func() time.Time { return now }
+	var persisted Token
+	ts.OnRefresh = func(token Token) { persisted = token }
+
+	token, err := ts.Token()
+	if err != nil || token.AccessToken != "access-0" {
+		t.Fatalf("Token() = %s, %v; want access-0, nil", token.AccessToken, err)
+	}
+
+	// within DefaultRefreshBefore of expiry
+	now = now.Add(90 * time.Minute)
+	token, err = ts.Token()
+	if err != nil || token.AccessToken != "access-1" {
+		t.Fatalf("Token() = %s, %v; want access-1, nil", token.AccessToken, err)
+	}
+	if persisted.AccessToken != "access-1" || persisted.RefreshToken != "refresh" {
+		t.Errorf("OnRefresh() token = %+v; want access-1 with previous refresh token", persisted)
+	}
+}
+
+// TestSessionRetryUnauthorized tests resending a request rejected with 401
+func TestSessionRetryUnauthorized(t *testing.T) {
+	var refreshes int32
+	app := newTokenTestApp(t, &refreshes)
+
+	api := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer access-1" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		_, _ = w.Write([]byte(`{}`))
+	}))
+	defer api.Close()
+
+	session := app.Session("")
+	session.BaseURL = api.URL
+	session.SetTokenSource(NewTokenSource(app, Token{AccessToken: "revoked", ExpiresIn: 5184000, RefreshToken: "refresh"}))
+
+	if _, _, err := session.Get("/me"); err != nil {
+		t.Fatalf("Get() error = %v; want nil", err)
+	}
+	if refreshes != 1 || session.AccessToken() != "access-1" {
+		t.Errorf("refreshes = %d, access token = %s; want 1, access-1", refreshes, session.AccessToken())
+	}
+}
+
+// TestRefreshingTokenSourceHungRefresh tests that a hung token endpoint
+// neither blocks callers beyond their context nor holds the lock
+func TestRefreshingTokenSourceHungRefresh(t *testing.T) {
+	release := make(chan struct{})
+	var refreshes int32
+	app := newTokenEndpointApp(t, nil, func(url.Values) (int, string) {
+		atomic.AddInt32(&refreshes, 1)
+		<-release
+		return http.StatusOK, `{"access_token":"access-1","expires_in":5184000}`
+	})
+	defer close(release)
+
+	ts := NewTokenSource(app, Token{AccessToken: "access-0", ExpiresIn: 60, RefreshToken: "refresh"})
+
+	for i := 0; i < 2; i++ {
+		ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+		_, err := ts.TokenWithContext(ctx)
+		cancel()
+		if !errors.Is(err, context.DeadlineExceeded) {
+			t.Fatalf("TokenWithContext() error = %v; want %v", err, context.DeadlineExceeded)
+		}
+	}
+	if got := ts.refreshToken(); got != "refresh" {
+		t.Errorf("refreshToken() = %s; want refresh", got)
+	}
+	if n := atomic.LoadInt32(&refreshes); n != 1 {
+		t.Errorf("refreshes = %d; want 1", n)
+	}
+}
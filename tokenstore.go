@@ -0,0 +1,223 @@
+package linkedin
+
+import (
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sync"
+)
+
+// ErrTokenNotFound is returned by a TokenStore if no token is stored for a key.
+var ErrTokenNotFound = errors.New("linkedIn: token not found")
+
+// TokenStore persists tokens by key, e.g. the URN of a member or organization admin.
+type TokenStore interface {
+	Get(ctx context.Context, key string) (Token, error)
+	Put(ctx context.Context, key string, token Token) error
+	Delete(ctx context.Context, key string) error
+}
+
+// MemoryTokenStore is an in-memory TokenStore. It is safe for concurrent use.
+type MemoryTokenStore struct {
+	mu     sync.RWMutex
+	tokens map[string]Token
+}
+
+// NewMemoryTokenStore creates an empty in-memory token store.
+func NewMemoryTokenStore() *MemoryTokenStore {
+	return &MemoryTokenStore{tokens: make(map[string]Token)}
+}
+
+// Get implements TokenStore.
+func (s *MemoryTokenStore) Get(_ context.Context, key string) (Token, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	token, ok := s.tokens[key]
+	if !ok {
+		return Token{}, ErrTokenNotFound
+	}
+	return token, nil
+}
+
+// Put implements TokenStore.
+func (s *MemoryTokenStore) Put(_ context.Context, key string, token Token) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.tokens[key] = token
+	return nil
+}
+
+// Delete implements TokenStore.
+func (s *MemoryTokenStore) Delete(_ context.Context, key string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	delete(s.tokens, key)
+	return nil
+}
+
+// FileTokenStore is a TokenStore which keeps all tokens in a single file
+// encrypted with AES-GCM. It is safe for concurrent use within a process.
+type FileTokenStore struct {
+	mu   sync.Mutex
+	path string
+	aead cipher.AEAD
+}
+
+// NewFileTokenStore creates a token store backed by the file at path.
+// key must be 16, 24 or 32 bytes long to select AES-128, AES-192 or AES-256.
+// The file is created on the first Put.
+func NewFileTokenStore(path string, key []byte) (*FileTokenStore, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, fmt.Errorf("linkedIn: invalid token store key; %w", err)
+	}
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, fmt.Errorf("linkedIn: cannot create token store cipher; %w", err)
+	}
+
+	return &FileTokenStore{path: path, aead: aead}, nil
+}
+
+// Get implements TokenStore.
+func (s *FileTokenStore) Get(_ context.Context, key string) (Token, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	tokens, err := s.load()
+	if err != nil {
+		return Token{}, err
+	}
+	token, ok := tokens[key]
+	if !ok {
+		return Token{}, ErrTokenNotFound
+	}
+	return token, nil
+}
+
+// Put implements TokenStore.
+func (s *FileTokenStore) Put(_ context.Context, key string, token Token) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	tokens, err := s.load()
+	if err != nil {
+		return err
+	}
+	tokens[key] = token
+	return s.save(tokens)
+}
+
+// Delete implements TokenStore.
+func (s *FileTokenStore) Delete(_ context.Context, key string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	tokens, err := s.load()
+	if err != nil {
+		return err
+	}
+	if _, ok := tokens[key]; !ok {
+		return nil
+	}
+	delete(tokens, key)
+	return s.save(tokens)
+}
+
+// load reads and decrypts all tokens, s.mu must be held.
+func (s *FileTokenStore) load() (map[string]Token, error) {
+	tokens := make(map[string]Token)
+
+	data, err := os.ReadFile(s.path)
+	if errors.Is(err, os.ErrNotExist) {
+		return tokens, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("linkedIn: cannot read token store; %w", err)
+	}
+
+	nonceSize := s.aead.NonceSize()
+	if len(data) < nonceSize {
+		return nil, fmt.Errorf("linkedIn: token store is corrupted")
+	}
+	plaintext, err := s.aead.Open(nil, data[:nonceSize], data[nonceSize:], nil)
+	if err != nil {
+		return nil, fmt.Errorf("linkedIn: cannot decrypt token store; %w", err)
+	}
+
+	if err := json.Unmarshal(plaintext, &tokens); err != nil {
+		return nil, fmt.Errorf("linkedIn: cannot decode token store; %w", err)
+	}
+	return tokens, nil
+}
+
+// save encrypts and writes all tokens, s.mu must be held.
+// The file is replaced atomically.
+func (s *FileTokenStore) save(tokens map[string]Token) error {
+	plaintext, err := json.Marshal(tokens)
+	if err != nil {
+		return fmt.Errorf("linkedIn: cannot encode token store; %w", err)
+	}
+
+	nonce := make([]byte, s.aead.NonceSize())
+	if _, err := io.ReadFull(rand.Reader, nonce); err != nil {
+		return fmt.Errorf("linkedIn: cannot generate nonce; %w", err)
+	}
+	data := s.aead.Seal(nonce, nonce, plaintext, nil)
+
+	tmp, err := os.CreateTemp(filepath.Dir(s.path), filepath.Base(s.path)+".*.tmp")
+	if err != nil {
+		return fmt.Errorf("linkedIn: cannot write token store; %w", err)
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := tmp.Write(data); err != nil {
+		_ = tmp.Close()
+		return fmt.Errorf("linkedIn: cannot write token store; %w", err)
+	}
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("linkedIn: cannot write token store; %w", err)
+	}
+	if err := os.Rename(tmp.Name(), s.path); err != nil {
+		return fmt.Errorf("linkedIn: cannot write token store; %w", err)
+	}
+	return nil
+}
+
+// SessionFromStore creates a new LinkedIn session with the token stored under key.
+//
+// The access token is refreshed before it expires and refreshed tokens
+// are written back to the store. onError is called if a refreshed token
+// cannot be written, e.g. to log the error or to write the token elsewhere;
+// the session keeps using the refreshed token. onError is required, because
+// a lost refresh token cannot be recovered without the member signing in again.
+func (app *App) SessionFromStore(ctx context.Context, store TokenStore, key string, onError func(token Token, err error)) (*Session, error) {
+	if onError == nil {
+		return nil, fmt.Errorf("linkedIn: onError is required to report token store failures")
+	}
+	token, err := store.Get(ctx, key)
+	if err != nil {
+		return nil, err
+	}
+
+	ts := NewTokenSource(app, token)
+	ts.OnRefresh = func(token Token) {
+		if err := store.Put(context.Background(), key, token); err != nil {
+			onError(token, err)
+		}
+	}
+
+	session := app.Session(token.AccessToken)
+	session.SetTokenSource(ts)
+	return session, nil
+}
@@ -0,0 +1,99 @@
+package linkedin
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+// TestFileTokenStore tests storing encrypted tokens in a file
+func TestFileTokenStore(t *testing.T) {
+	ctx := context.Background()
+	path := filepath.Join(t.TempDir(), "tokens")
+	key := bytes.Repeat([]byte{1}, 32)
+
+	store, err := NewFileTokenStore(path, key)
+	if err != nil {
+		t.Fatalf("NewFileTokenStore() error = %v", err)
+	}
+	if _, err := store.Get(ctx, "urn:li:person:a"); !errors.Is(err, ErrTokenNotFound) {
+		t.Errorf("Get() on empty store error = %v; want ErrTokenNotFound", err)
+	}
+
+	token := Token{AccessToken: "secret-access-token", ExpiresIn: 60}
+	token.setExpiry(time.Unix(1700000000, 0))
+	if err := store.Put(ctx, "urn:li:person:a", token); err != nil {
+		t.Fatalf("Put() error = %v", err)
+	}
+
+	data, _ := os.ReadFile(path)
+	if bytes.Contains(data, []byte(token.AccessToken)) {
+		t.Errorf("token store file contains the plain access token")
+	}
+
+	got, err := store.Get(ctx, "urn:li:person:a")
+	if err != nil || got.AccessToken != token.AccessToken || !got.ExpiresAt.Equal(token.ExpiresAt) {
+		t.Errorf("Get() = %+v, %v; want %+v", got, err, token)
+	}
+
+	other, _ := NewFileTokenStore(path, bytes.Repeat([]byte{2}, 32))
+	if _, err := other.Get(ctx, "urn:li:person:a"); err == nil {
+		t.Errorf("Get() with wrong key error = nil; want error")
+	}
+
+	if err := store.Delete(ctx, "urn:li:person:a"); err != nil {
+		t.Fatalf("Delete() error = %v", err)
+	}
+	if _, err := store.Get(ctx, "urn:li:person:a"); !errors.Is(err, ErrTokenNotFound) {
+		t.Errorf("Get() after Delete() error = %v; want ErrTokenNotFound", err)
+	}
+
+	if _, err := NewFileTokenStore(path, []byte("short")); err == nil {
+		t.Errorf("NewFileTokenStore() with short key error = nil; want error")
+	}
+}
+
+// failingTokenStore is a TokenStore whose writes fail
+type failingTokenStore struct {
+	*MemoryTokenStore
+}
+
+// Put implements TokenStore.
+func (s failingTokenStore) Put(ctx context.Context, key string, token Token) error {
+	return errors.New("disk full")
+}
+
+// TestSessionFromStorePutError tests reporting a refreshed token which cannot be stored
+func TestSessionFromStorePutError(t *testing.T) {
+	var refreshes int32
+	app := newTokenTestApp(t, &refreshes)
+	ctx := context.Background()
+
+	memory := NewMemoryTokenStore()
+	_ = memory.Put(ctx, "member", Token{AccessToken: "access-0", RefreshToken: "refresh", ExpiresAt: time.Now().Add(time.Minute)})
+	store := failingTokenStore{memory}
+
+	if _, err := app.SessionFromStore(ctx, store, "member", nil); err == nil {
+		t.Errorf("SessionFromStore() without onError error = nil; want error")
+	}
+
+	var lost Token
+	var storeErr error
+	session, err := app.SessionFromStore(ctx, store, "member", func(token Token, err error) {
+		lost, storeErr = token, err
+	})
+	if err != nil {
+		t.Fatalf("SessionFromStore() error = %v", err)
+	}
+
+	if token := session.AccessToken(); token != "access-1" {
+		t.Errorf("AccessToken() = %s; want access-1", token)
+	}
+	if storeErr == nil || lost.AccessToken != "access-1" || lost.RefreshToken != "refresh" {
+		t.Errorf("onError() = %+v, %v; want access-1 and the store error", lost, storeErr)
+	}
+}
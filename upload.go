@@ -0,0 +1,134 @@
+package linkedin
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// Media processing states of images and videos
+const (
+	MediaStatusWaitingUpload    = "WAITING_UPLOAD"
+	MediaStatusProcessing       = "PROCESSING"
+	MediaStatusAvailable        = "AVAILABLE"
+	MediaStatusProcessingFailed = "PROCESSING_FAILED"
+)
+
+// Default polling of uploaded media until it is processed
+const (
+	DefaultPollInterval = 2 * time.Second
+	DefaultPollTimeout  = 5 * time.Minute
+)
+
+// ErrMediaProcessingFailed is returned when LinkedIn cannot process uploaded media.
+var ErrMediaProcessingFailed = errors.New("linkedIn: media processing failed")
+
+// UploadHosts - hosts of LinkedIn upload URLs which receive the access token
+// in addition to the API origin, e.g. www.linkedin.com/dms-uploads
+var UploadHosts = []string{"www.linkedin.com", "api.linkedin.com"}
+
+// upload streams body to an upload URL returned by an initializeUpload action.
+// The body is not buffered, so the request is sent once without retries.
+// If size is positive, it is sent as Content-Length.
+func (session *Session) upload(ctx context.Context, uploadURL, contentType string, body io.Reader, size int64) (*Response, error) {
+	if ctx == nil {
+		ctx = session.Context()
+	}
+
+	request, err := http.NewRequestWithContext(ctx, http.MethodPut, uploadURL, body)
+	if err != nil {
+		return nil, fmt.Errorf("linkedIn: cannot create upload request; %w", err)
+	}
+	if size > 0 {
+		request.ContentLength = size
+	}
+	if contentType != "" {
+		request.Header.Set(string(ContentType), contentType)
+	}
+
+	if session.useAuthorizationHeader && session.trustedUploadURL(request.URL) {
+		accessToken, err := session.currentAccessToken(ctx)
+		if err != nil {
+			return nil, err
+		}
+		request.Header.Set(string(Authorization), "Bearer "+accessToken)
+	}
+
+	return session.do(request)
+}
+
+// trustedUploadURL reports whether the access token may be sent to an upload URL.
+func (session *Session) trustedUploadURL(u *url.URL) bool {
+	if session.trustedURL(u) {
+		return true
+	}
+	if u.Scheme != "https" {
+		return false
+	}
+	for _, host := range UploadHosts {
+		if strings.EqualFold(u.Host, host) {
+			return true
+		}
+	}
+	return false
+}
+
+// pollStatus calls status until it reports AVAILABLE or PROCESSING_FAILED,
+// the timeout expires or ctx is done.
+func pollStatus(ctx context.Context, interval, timeout time.Duration, status func(ctx context.Context) (string, error)) error {
+	if interval <= 0 {
+		interval = DefaultPollInterval
+	}
+	if timeout <= 0 {
+		timeout = DefaultPollTimeout
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	for {
+		s, err := status(ctx)
+		if err != nil {
+			return err
+		}
+		switch s {
+		case MediaStatusAvailable:
+			return nil
+		case MediaStatusProcessingFailed:
+			return ErrMediaProcessingFailed
+		}
+
+		timer := time.NewTimer(interval)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return fmt.Errorf("linkedIn: media is still %s; %w", s, ctx.Err())
+		case <-timer.C:
+		}
+	}
+}
+
+// limitedReader reads from r and fails once more than n bytes are read.
+type limitedReader struct {
+	r        io.Reader
+	n        int64
+	exceeded bool
+}
+
+// Read implements io.Reader.
+func (l *limitedReader) Read(p []byte) (int, error) {
+	if int64(len(p)) > l.n+1 {
+		p = p[:l.n+1]
+	}
+	n, err := l.r.Read(p)
+	if int64(n) > l.n {
+		l.exceeded = true
+		return 0, fmt.Errorf("linkedIn: upload size limit exceeded")
+	}
+	l.n -= int64(n)
+	return n, err
+}
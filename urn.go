@@ -0,0 +1,225 @@
+package linkedin
+
+import (
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// URN - LinkedIn Uniform Resource Name, e.g. urn:li:organization:123456
+//
+// The entity ID can be a tuple of nested URNs and values,
+// e.g. urn:li:organizationalEntityShareStatistics:(urn:li:organization:1,urn:li:share:2)
+//
+// See: https://learn.microsoft.com/en-us/linkedin/shared/api-guide/concepts/urns
+type URN struct {
+	Namespace  string // e.g. li
+	EntityType string // e.g. organization
+	ID         string // e.g. 123456
+}
+
+// URN namespace used by LinkedIn
+const URNNamespace = "li"
+
+// LinkedIn URN entity types
+const (
+	EntityPerson            = "person"
+	EntityOrganization      = "organization"
+	EntityShare             = "share"
+	EntityUGCPost           = "ugcPost"
+	EntityImage             = "image"
+	EntityVideo             = "video"
+	EntityDocument          = "document"
+	EntitySponsoredAccount  = "sponsoredAccount"
+	EntitySponsoredCampaign = "sponsoredCampaign"
+	EntityComment           = "comment"
+)
+
+// NewURN creates a URN in the `li` namespace.
+func NewURN(entityType, id string) URN {
+	return URN{Namespace: URNNamespace, EntityType: entityType, ID: id}
+}
+
+// NewTupleURN creates a URN in the `li` namespace with a tuple entity ID,
+// e.g. urn:li:comment:(urn:li:activity:1,2)
+func NewTupleURN(entityType string, items ...string) URN {
+	return NewURN(entityType, LeftBracket+strings.Join(items, ListItemSep)+RightBracket)
+}
+
+// PersonURN returns the URN of a member, e.g. urn:li:person:a1b2c3
+func PersonURN(id string) URN {
+	return NewURN(EntityPerson, id)
+}
+
+// OrganizationURN returns the URN of an organization, e.g. urn:li:organization:123456
+func OrganizationURN(id int64) URN {
+	return NewURN(EntityOrganization, strconv.FormatInt(id, 10))
+}
+
+// ShareURN returns the URN of a share, e.g. urn:li:share:123456
+func ShareURN(id string) URN {
+	return NewURN(EntityShare, id)
+}
+
+// UGCPostURN returns the URN of a UGC post, e.g. urn:li:ugcPost:123456
+func UGCPostURN(id string) URN {
+	return NewURN(EntityUGCPost, id)
+}
+
+// ImageURN returns the URN of an image, e.g. urn:li:image:C4E10AQ
+func ImageURN(id string) URN {
+	return NewURN(EntityImage, id)
+}
+
+// VideoURN returns the URN of a video, e.g. urn:li:video:C5F10AQ
+func VideoURN(id string) URN {
+	return NewURN(EntityVideo, id)
+}
+
+// SponsoredAccountURN returns the URN of an ad account, e.g. urn:li:sponsoredAccount:123456
+func SponsoredAccountURN(id int64) URN {
+	return NewURN(EntitySponsoredAccount, strconv.FormatInt(id, 10))
+}
+
+// SponsoredCampaignURN returns the URN of an ad campaign, e.g. urn:li:sponsoredCampaign:123456
+func SponsoredCampaignURN(id int64) URN {
+	return NewURN(EntitySponsoredCampaign, strconv.FormatInt(id, 10))
+}
+
+// ParseURN parses a URN of the form urn:{namespace}:{entityType}:{id}.
+func ParseURN(s string) (URN, error) {
+	parts := strings.SplitN(s, ObjKeyValSep, 4)
+	if len(parts) != 4 || parts[0] != "urn" {
+		return URN{}, fmt.Errorf("linkedIn: invalid URN %q", s)
+	}
+	if parts[1] == "" || parts[2] == "" || parts[3] == "" {
+		return URN{}, fmt.Errorf("linkedIn: invalid URN %q", s)
+	}
+
+	urn := URN{Namespace: parts[1], EntityType: parts[2], ID: parts[3]}
+	if strings.HasPrefix(urn.ID, LeftBracket) {
+		if _, err := splitTuple(urn.ID); err != nil {
+			return URN{}, fmt.Errorf("linkedIn: invalid URN %q; %w", s, err)
+		}
+	} else if strings.ContainsAny(urn.ID, LeftBracket+RightBracket) {
+		return URN{}, fmt.Errorf("linkedIn: invalid URN %q", s)
+	}
+	return urn, nil
+}
+
+// MustParseURN is like ParseURN but panics if s cannot be parsed.
+func MustParseURN(s string) URN {
+	urn, err := ParseURN(s)
+	if err != nil {
+		panic(err)
+	}
+	return urn
+}
+
+// String returns the URN in its canonical form, or an empty string for the zero URN.
+func (u URN) String() string {
+	if u.IsZero() {
+		return ""
+	}
+	return "urn" + ObjKeyValSep + u.Namespace + ObjKeyValSep + u.EntityType + ObjKeyValSep + u.ID
+}
+
+// IsZero reports whether u is the zero URN.
+func (u URN) IsZero() bool {
+	return u == URN{}
+}
+
+// IsTuple reports whether the entity ID of u is a tuple.
+func (u URN) IsTuple() bool {
+	return strings.HasPrefix(u.ID, LeftBracket)
+}
+
+// Tuple returns the items of a tuple entity ID, e.g.
+// [urn:li:organization:1 urn:li:share:2] for
+// urn:li:organizationalEntityShareStatistics:(urn:li:organization:1,urn:li:share:2)
+//
+// Nested URNs can be parsed with ParseURN.
+// It returns nil if the entity ID is not a tuple.
+func (u URN) Tuple() []string {
+	if !u.IsTuple() {
+		return nil
+	}
+	items, _ := splitTuple(u.ID)
+	return items
+}
+
+// RestLiString returns the URN escaped for use in Rest.li paths and query strings.
+func (u URN) RestLiString() string {
+	return escapeRestLi(u.String())
+}
+
+// MarshalText implements encoding.TextMarshaler.
+// URNs are encoded as JSON strings and Rest.li primitives.
+func (u URN) MarshalText() ([]byte, error) {
+	return []byte(u.String()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+// An empty string is decoded as the zero URN. Percent-encoded URNs,
+// e.g. keys of batch responses, are unescaped.
+func (u *URN) UnmarshalText(text []byte) error {
+	if len(text) == 0 {
+		*u = URN{}
+		return nil
+	}
+
+	s := string(text)
+	if strings.HasPrefix(s, "urn%3A") || strings.HasPrefix(s, "urn%3a") {
+		unescaped, err := url.PathUnescape(s)
+		if err != nil {
+			return fmt.Errorf("linkedIn: invalid URN %q; %w", s, err)
+		}
+		s = unescaped
+	}
+
+	urn, err := ParseURN(s)
+	if err != nil {
+		return err
+	}
+	*u = urn
+	return nil
+}
+
+// splitTuple splits a tuple such as (a,(b,c),d) into its top-level items.
+func splitTuple(tuple string) ([]string, error) {
+	if !strings.HasPrefix(tuple, LeftBracket) || !strings.HasSuffix(tuple, RightBracket) {
+		return nil, fmt.Errorf("unbalanced tuple %s", tuple)
+	}
+
+	var items []string
+	depth := 0
+	start := 1
+	for i := 0; i < len(tuple); i++ {
+		switch tuple[i] {
+		case '(':
+			depth++
+		case ')':
+			depth--
+			if depth < 0 || (depth == 0 && i != len(tuple)-1) {
+				return nil, fmt.Errorf("unbalanced tuple %s", tuple)
+			}
+		case ',':
+			if depth == 1 {
+				items = append(items, tuple[start:i])
+				start = i + 1
+			}
+		}
+	}
+	if depth != 0 {
+		return nil, fmt.Errorf("unbalanced tuple %s", tuple)
+	}
+	items = append(items, tuple[start:len(tuple)-1])
+
+	for _, item := range items {
+		if item == "" {
+			return nil, fmt.Errorf("empty item in tuple %s", tuple)
+		}
+	}
+	return items, nil
+}
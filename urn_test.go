@@ -0,0 +1,72 @@
+package linkedin
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+// TestParseURN tests parsing simple and compound URNs
+func TestParseURN(t *testing.T) {
+	tests := []struct {
+		value string
+		want  URN
+		tuple []string
+	}{
+		{"urn:li:organization:123", OrganizationURN(123), nil},
+		{"urn:li:person:a1b2c3", PersonURN("a1b2c3"), nil},
+		{
+			"urn:li:organizationalEntityShareStatistics:(urn:li:organization:1,urn:li:share:2)",
+			URN{"li", "organizationalEntityShareStatistics", "(urn:li:organization:1,urn:li:share:2)"},
+			[]string{"urn:li:organization:1", "urn:li:share:2"},
+		},
+		{
+			"urn:li:comment:(urn:li:activity:(urn:li:share:1,2),3)",
+			NewTupleURN(EntityComment, "urn:li:activity:(urn:li:share:1,2)", "3"),
+			[]string{"urn:li:activity:(urn:li:share:1,2)", "3"},
+		},
+	}
+
+	for _, test := range tests {
+		got, err := ParseURN(test.value)
+		if err != nil || got != test.want {
+			t.Errorf("ParseURN(%s) = %+v, %v; want %+v, nil", test.value, got, err, test.want)
+			continue
+		}
+		if got.String() != test.value {
+			t.Errorf("String() = %s; want %s", got, test.value)
+		}
+		if !reflect.DeepEqual(got.Tuple(), test.tuple) {
+			t.Errorf("Tuple() = %v; want %v", got.Tuple(), test.tuple)
+		}
+	}
+
+	for _, invalid := range []string{"", "urn:li:organization", "urn:li:organization:", "id:li:organization:1", "urn:li:comment:(1,2", "urn:li:comment:(1,)", "urn:li:x:(1))("} {
+		if _, err := ParseURN(invalid); err == nil {
+			t.Errorf("ParseURN(%s) error = nil; want error", invalid)
+		}
+	}
+}
+
+// TestURNEncoding tests JSON and Rest.li encoding of URNs
+func TestURNEncoding(t *testing.T) {
+	type author struct {
+		Author URN `json:"author"`
+	}
+
+	data, err := json.Marshal(author{Author: OrganizationURN(1)})
+	if err != nil || string(data) != `{"author":"urn:li:organization:1"}` {
+		t.Errorf("json.Marshal() = %s, %v", data, err)
+	}
+
+	var a author
+	if err := json.Unmarshal(data, &a); err != nil || a.Author != OrganizationURN(1) {
+		t.Errorf("json.Unmarshal() = %+v, %v", a, err)
+	}
+
+	encoded, err := EncodeRestLi([]URN{ShareURN("1"), UGCPostURN("2")})
+	want := "List(urn%3Ali%3Ashare%3A1,urn%3Ali%3AugcPost%3A2)"
+	if err != nil || encoded != want {
+		t.Errorf("EncodeRestLi() = %s, %v; want %s", encoded, err, want)
+	}
+}
@@ -0,0 +1,368 @@
+package linkedin
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"sync"
+	"time"
+)
+
+// DefaultVideoConcurrency is the default number of video parts uploaded in parallel.
+const DefaultVideoConcurrency = 4
+
+// VideosService - LinkedIn Videos API
+//
+// See: https://learn.microsoft.com/en-us/linkedin/marketing/community-management/shares/videos-api
+type VideosService struct {
+	session *Session
+}
+
+// Videos returns the Videos API service of the session.
+func (session *Session) Videos() *VideosService {
+	return &VideosService{session: session}
+}
+
+// ElementVideo struct for LinkedIn videos
+type ElementVideo struct {
+	ID                   string  `json:"id"`     // e.g. urn:li:video:C5F10AQ
+	Owner                string  `json:"owner"`  // e.g. urn:li:organization:123456
+	Status               string  `json:"status"` // e.g. AVAILABLE
+	Duration             int64   `json:"duration,omitempty"`
+	AspectRatioWidth     float64 `json:"aspectRatioWidth,omitempty"`
+	AspectRatioHeight    float64 `json:"aspectRatioHeight,omitempty"`
+	DownloadURL          string  `json:"downloadUrl,omitempty"`
+	DownloadURLExpiresAt int64   `json:"downloadUrlExpiresAt,omitempty"`
+	ThumbnailURL         string  `json:"thumbnail,omitempty"`
+}
+
+// UploadInstruction struct for a part of a video upload
+type UploadInstruction struct {
+	UploadURL string `json:"uploadUrl"`
+	FirstByte int64  `json:"firstByte"`
+	LastByte  int64  `json:"lastByte"`
+}
+
+// VideoUploadState struct for the progress of a video upload.
+//
+// The state can be encoded as JSON and passed as UploadVideoRequest.State
+// to resume an interrupted upload before its upload URLs expire.
+type VideoUploadState struct {
+	Video              string              `json:"video"` // e.g. urn:li:video:C5F10AQ
+	FileSize           int64               `json:"fileSize"`
+	UploadToken        string              `json:"uploadToken"`
+	UploadURLsExpireAt int64               `json:"uploadUrlsExpireAt"` // milliseconds since epoch
+	UploadInstructions []UploadInstruction `json:"uploadInstructions"`
+	PartIDs            []string            `json:"partIds"` // ETags of uploaded parts, empty if pending
+	CaptionsUploadURL  string              `json:"captionsUploadUrl,omitempty"`
+	ThumbnailUploadURL string              `json:"thumbnailUploadUrl,omitempty"`
+	CaptionsUploaded   bool                `json:"captionsUploaded,omitempty"`
+	ThumbnailUploaded  bool                `json:"thumbnailUploaded,omitempty"`
+	Finalized          bool                `json:"finalized,omitempty"`
+}
+
+// uploaded returns the number of bytes of all uploaded parts.
+func (state *VideoUploadState) uploaded() int64 {
+	var n int64
+	for i, id := range state.PartIDs {
+		if id != "" {
+			n += state.UploadInstructions[i].LastByte - state.UploadInstructions[i].FirstByte + 1
+		}
+	}
+	return n
+}
+
+// expired reports whether the upload URLs of the state have expired.
+func (state *VideoUploadState) expired(now time.Time) bool {
+	return state.UploadURLsExpireAt > 0 && now.UnixMilli() >= state.UploadURLsExpireAt
+}
+
+// UploadVideoRequest struct for uploading a video
+type UploadVideoRequest struct {
+	Owner        URN           // person or organization, e.g. urn:li:organization:123456
+	Reader       io.ReaderAt   // MP4 video, parts are read concurrently
+	Size         int64         // size of the video in bytes
+	Title        string        // title used when the video is attached to a post
+	Captions     io.Reader     // SRT captions, optional
+	Thumbnail    io.Reader     // JPG or PNG thumbnail, optional
+	Concurrency  int           // DefaultVideoConcurrency if 0
+	PollInterval time.Duration // DefaultPollInterval if 0
+	PollTimeout  time.Duration // DefaultPollTimeout if 0
+
+	// State resumes a previous upload of the same video, optional
+	State *VideoUploadState
+	// OnState is called whenever the upload state changes, e.g. to persist it
+	OnState func(state VideoUploadState)
+	// OnProgress is called after each uploaded part
+	OnProgress func(uploaded, total int64)
+}
+
+// UploadedVideo struct for a video which is ready to be used in a post
+type UploadedVideo struct {
+	URN   URN    // e.g. urn:li:video:C5F10AQ
+	Title string // title from the upload request
+}
+
+// Content returns the post content to attach the video to a post.
+func (v UploadedVideo) Content() *ContentPost {
+	return &ContentPost{
+		Media: &MediaContentPost{
+			ID:    v.URN.String(),
+			Title: v.Title,
+		},
+	}
+}
+
+// initializeUploadVideo struct for the response of the initializeUpload action
+type initializeUploadVideo struct {
+	Value struct {
+		Video              string              `json:"video"`
+		UploadToken        string              `json:"uploadToken"`
+		UploadURLsExpireAt int64               `json:"uploadUrlsExpireAt"`
+		UploadInstructions []UploadInstruction `json:"uploadInstructions"`
+		CaptionsUploadURL  string              `json:"captionsUploadUrl"`
+		ThumbnailUploadURL string              `json:"thumbnailUploadUrl"`
+	} `json:"value"`
+}
+
+// Upload uploads a video and waits until LinkedIn has processed it.
+//
+// The video is registered with the initializeUpload action, its parts are
+// uploaded concurrently to the returned upload URLs, captions and thumbnail
+// are uploaded if set, and the upload is completed with the finalizeUpload
+// action. The video is then polled until it is AVAILABLE or PROCESSING_FAILED.
+func (s *VideosService) Upload(ctx context.Context, req UploadVideoRequest) (UploadedVideo, error) {
+	if ctx == nil {
+		ctx = s.session.Context()
+	}
+	if req.Owner.IsZero() {
+		return UploadedVideo{}, fmt.Errorf("linkedIn: video owner is required")
+	}
+	if req.Reader == nil || req.Size <= 0 {
+		return UploadedVideo{}, fmt.Errorf("linkedIn: video reader and size are required")
+	}
+	if err := s.session.RequireScopes(PostWriteScopes(req.Owner)...); err != nil {
+		return UploadedVideo{}, err
+	}
+
+	// resume the previous upload unless it has expired
+	state := req.State
+	if state != nil && state.Video != "" && !state.Finalized && state.expired(time.Now()) {
+		state = nil
+	}
+	if state != nil && state.Video != "" && state.FileSize != req.Size {
+		return UploadedVideo{}, fmt.Errorf("linkedIn: upload state of %s is for %d bytes, not %d", state.Video, state.FileSize, req.Size)
+	}
+	if state == nil || state.Video == "" {
+		initialized, err := s.initializeUpload(ctx, req)
+		if err != nil {
+			return UploadedVideo{}, err
+		}
+		state = initialized
+		s.notify(req, state)
+	}
+
+	urn, err := ParseURN(state.Video)
+	if err != nil {
+		return UploadedVideo{}, err
+	}
+
+	if !state.Finalized {
+		if err := s.uploadParts(ctx, req, state); err != nil {
+			return UploadedVideo{}, err
+		}
+		if err := s.uploadExtras(ctx, req, state); err != nil {
+			return UploadedVideo{}, err
+		}
+		if err := s.finalizeUpload(ctx, urn, state); err != nil {
+			return UploadedVideo{}, err
+		}
+		state.Finalized = true
+		s.notify(req, state)
+	}
+
+	// wait until the video can be used in a post
+	err = pollStatus(ctx, req.PollInterval, req.PollTimeout, func(ctx context.Context) (string, error) {
+		video, err := s.Get(ctx, urn)
+		return video.Status, err
+	})
+	if err != nil {
+		return UploadedVideo{}, err
+	}
+
+	return UploadedVideo{URN: urn, Title: req.Title}, nil
+}
+
+// Get returns the video with the given URN, e.g. urn:li:video:C5F10AQ
+func (s *VideosService) Get(ctx context.Context, urn URN) (ElementVideo, error) {
+	response, err := s.session.Do(ctx, Get, "/videos/"+urn.RestLiString(), nil, nil)
+	if err != nil {
+		return ElementVideo{}, err
+	}
+
+	var video ElementVideo
+	err = response.Decode(&video)
+	return video, err
+}
+
+// initializeUpload registers a video upload and returns its initial state.
+func (s *VideosService) initializeUpload(ctx context.Context, req UploadVideoRequest) (*VideoUploadState, error) {
+	response, err := s.session.Do(ctx, Action, "/videos?action=initializeUpload", nil, Params{
+		"initializeUploadRequest": Params{
+			"owner":           req.Owner.String(),
+			"fileSizeBytes":   req.Size,
+			"uploadCaptions":  req.Captions != nil,
+			"uploadThumbnail": req.Thumbnail != nil,
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var initialized initializeUploadVideo
+	if err := response.Decode(&initialized); err != nil {
+		return nil, err
+	}
+	value := initialized.Value
+	if value.Video == "" || len(value.UploadInstructions) == 0 {
+		return nil, fmt.Errorf("linkedIn: upload instructions are missing")
+	}
+
+	return &VideoUploadState{
+		Video:              value.Video,
+		FileSize:           req.Size,
+		UploadToken:        value.UploadToken,
+		UploadURLsExpireAt: value.UploadURLsExpireAt,
+		UploadInstructions: value.UploadInstructions,
+		PartIDs:            make([]string, len(value.UploadInstructions)),
+		CaptionsUploadURL:  value.CaptionsUploadURL,
+		ThumbnailUploadURL: value.ThumbnailUploadURL,
+	}, nil
+}
+
+// uploadParts uploads the pending parts of the video concurrently
+// and records their ETags in the state.
+func (s *VideosService) uploadParts(ctx context.Context, req UploadVideoRequest, state *VideoUploadState) error {
+	if len(state.PartIDs) != len(state.UploadInstructions) {
+		state.PartIDs = make([]string, len(state.UploadInstructions))
+	}
+
+	concurrency := req.Concurrency
+	if concurrency <= 0 {
+		concurrency = DefaultVideoConcurrency
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var (
+		mu       sync.Mutex
+		firstErr error
+		wg       sync.WaitGroup
+	)
+	uploaded := state.uploaded()
+	parts := make(chan int)
+
+	for w := 0; w < concurrency; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range parts {
+				part := state.UploadInstructions[i]
+				size := part.LastByte - part.FirstByte + 1
+				body := io.NewSectionReader(req.Reader, part.FirstByte, size)
+
+				response, err := s.session.upload(ctx, part.UploadURL, string(OctetStream), body, size)
+				if err == nil && response.Header.Get(string(ETag)) == "" {
+					err = fmt.Errorf("linkedIn: ETag is missing for bytes %d-%d", part.FirstByte, part.LastByte)
+				}
+
+				mu.Lock()
+				if err != nil {
+					if firstErr == nil {
+						firstErr = err
+						cancel()
+					}
+				} else {
+					state.PartIDs[i] = response.Header.Get(string(ETag))
+					uploaded += size
+					s.notify(req, state)
+					if req.OnProgress != nil {
+						req.OnProgress(uploaded, state.FileSize)
+					}
+				}
+				mu.Unlock()
+			}
+		}()
+	}
+
+	for i := range state.UploadInstructions {
+		if state.PartIDs[i] != "" {
+			continue
+		}
+		select {
+		case parts <- i:
+		case <-ctx.Done():
+		}
+		if ctx.Err() != nil {
+			break
+		}
+	}
+	close(parts)
+	wg.Wait()
+
+	if firstErr != nil {
+		return firstErr
+	}
+	return ctx.Err()
+}
+
+// uploadExtras uploads the captions and the thumbnail of the video.
+func (s *VideosService) uploadExtras(ctx context.Context, req UploadVideoRequest, state *VideoUploadState) error {
+	if req.Captions != nil && !state.CaptionsUploaded {
+		if state.CaptionsUploadURL == "" {
+			return fmt.Errorf("linkedIn: captions upload URL is missing for %s", state.Video)
+		}
+		if _, err := s.session.upload(ctx, state.CaptionsUploadURL, string(OctetStream), req.Captions, 0); err != nil {
+			return err
+		}
+		state.CaptionsUploaded = true
+		s.notify(req, state)
+	}
+
+	if req.Thumbnail != nil && !state.ThumbnailUploaded {
+		if state.ThumbnailUploadURL == "" {
+			return fmt.Errorf("linkedIn: thumbnail upload URL is missing for %s", state.Video)
+		}
+		if _, err := s.session.upload(ctx, state.ThumbnailUploadURL, string(OctetStream), req.Thumbnail, 0); err != nil {
+			return err
+		}
+		state.ThumbnailUploaded = true
+		s.notify(req, state)
+	}
+
+	return nil
+}
+
+// finalizeUpload completes the upload with the ETags of all parts.
+func (s *VideosService) finalizeUpload(ctx context.Context, urn URN, state *VideoUploadState) error {
+	_, err := s.session.Do(ctx, Action, "/videos?action=finalizeUpload", nil, Params{
+		"finalizeUploadRequest": Params{
+			"video":           urn.String(),
+			"uploadToken":     state.UploadToken,
+			"uploadedPartIds": state.PartIDs,
+		},
+	})
+	return err
+}
+
+// notify passes a copy of the state to the OnState callback.
+func (s *VideosService) notify(req UploadVideoRequest, state *VideoUploadState) {
+	if req.OnState == nil {
+		return
+	}
+	copied := *state
+	copied.UploadInstructions = append([]UploadInstruction(nil), state.UploadInstructions...)
+	copied.PartIDs = append([]string(nil), state.PartIDs...)
+	req.OnState(copied)
+}
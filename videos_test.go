@@ -0,0 +1,242 @@
+package linkedin
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+// fakeVideoServer is a fake Videos API and upload server
+type fakeVideoServer struct {
+	t           *testing.T
+	server      *httptest.Server
+	partSize    int64
+	failPart    int // part which fails once with 500, -1 for none
+	finalStatus string
+
+	mu          sync.Mutex
+	initialized int
+	parts       map[int][]byte
+	captions    []byte
+	thumbnail   []byte
+	finalized   []string
+	polls       int
+}
+
+// newFakeVideoServer returns a fake Videos API splitting uploads into parts of partSize bytes
+func newFakeVideoServer(t *testing.T, partSize int64) (*fakeVideoServer, *Session) {
+	f := &fakeVideoServer{t: t, partSize: partSize, failPart: -1, finalStatus: MediaStatusAvailable, parts: map[int][]byte{}}
+	f.server = httptest.NewServer(http.HandlerFunc(f.serveHTTP))
+	t.Cleanup(f.server.Close)
+
+	session := New("client", "secret").Session("token")
+	session.UseAuthorizationHeader()
+	session.BaseURL = f.server.URL
+	return f, session
+}
+
+func (f *fakeVideoServer) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	switch {
+	case r.Method == http.MethodPost && r.URL.Query().Get("action") == "initializeUpload":
+		var body struct {
+			Request struct {
+				Owner           string `json:"owner"`
+				FileSizeBytes   int64  `json:"fileSizeBytes"`
+				UploadCaptions  bool   `json:"uploadCaptions"`
+				UploadThumbnail bool   `json:"uploadThumbnail"`
+			} `json:"initializeUploadRequest"`
+		}
+		_ = json.NewDecoder(r.Body).Decode(&body)
+		f.initialized++
+
+		var instructions []string
+		for first, i := int64(0), 0; first < body.Request.FileSizeBytes; first, i = first+f.partSize, i+1 {
+			last := first + f.partSize - 1
+			if last >= body.Request.FileSizeBytes {
+				last = body.Request.FileSizeBytes - 1
+			}
+			instructions = append(instructions, fmt.Sprintf(`{"uploadUrl":"%s/upload/%d","firstByte":%d,"lastByte":%d}`, f.server.URL, i, first, last))
+		}
+		captionsURL, thumbnailURL := "", ""
+		if body.Request.UploadCaptions {
+			captionsURL = f.server.URL + "/captions"
+		}
+		if body.Request.UploadThumbnail {
+			thumbnailURL = f.server.URL + "/thumbnail"
+		}
+		fmt.Fprintf(w, `{"value":{"video":"urn:li:video:C5F10AQ","uploadToken":"token-1","uploadUrlsExpireAt":%d,"uploadInstructions":[%s],"captionsUploadUrl":%q,"thumbnailUploadUrl":%q}}`,
+			time.Now().Add(time.Hour).UnixMilli(), strings.Join(instructions, ","), captionsURL, thumbnailURL)
+	case r.Method == http.MethodPut && strings.HasPrefix(r.URL.Path, "/upload/"):
+		i, _ := strconv.Atoi(strings.TrimPrefix(r.URL.Path, "/upload/"))
+		if r.Header.Get(string(Authorization)) != "Bearer token" {
+			f.t.Errorf("unexpected upload headers %v", r.Header)
+		}
+		data, _ := io.ReadAll(r.Body)
+		if i == f.failPart {
+			f.failPart = -1
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		f.parts[i] = data
+		w.Header().Set(string(ETag), fmt.Sprintf("etag-%d", i))
+		w.WriteHeader(http.StatusOK)
+	case r.Method == http.MethodPut && r.URL.Path == "/captions":
+		f.captions, _ = io.ReadAll(r.Body)
+	case r.Method == http.MethodPut && r.URL.Path == "/thumbnail":
+		f.thumbnail, _ = io.ReadAll(r.Body)
+	case r.Method == http.MethodPost && r.URL.Query().Get("action") == "finalizeUpload":
+		var body struct {
+			Request struct {
+				Video           string   `json:"video"`
+				UploadToken     string   `json:"uploadToken"`
+				UploadedPartIDs []string `json:"uploadedPartIds"`
+			} `json:"finalizeUploadRequest"`
+		}
+		_ = json.NewDecoder(r.Body).Decode(&body)
+		if body.Request.Video != "urn:li:video:C5F10AQ" || body.Request.UploadToken != "token-1" {
+			f.t.Errorf("unexpected finalize request %+v", body.Request)
+		}
+		f.finalized = body.Request.UploadedPartIDs
+	case r.Method == http.MethodGet && r.URL.EscapedPath() == "/videos/urn%3Ali%3Avideo%3AC5F10AQ":
+		f.polls++
+		status := MediaStatusProcessing
+		if f.polls > 1 {
+			status = f.finalStatus
+		}
+		fmt.Fprintf(w, `{"id":"urn:li:video:C5F10AQ","status":%q}`, status)
+	default:
+		f.t.Errorf("unexpected request %s %s", r.Method, r.URL)
+		w.WriteHeader(http.StatusNotFound)
+	}
+}
+
+// TestVideosUpload tests a concurrent multipart upload with captions and thumbnail
+func TestVideosUpload(t *testing.T) {
+	f, session := newFakeVideoServer(t, 10)
+	video := bytes.Repeat([]byte("0123456789abcdef"), 4) // 64 bytes, 7 parts
+
+	var lastProgress int64
+	var states int
+	var mu sync.Mutex
+	v, err := session.Videos().Upload(context.Background(), UploadVideoRequest{
+		Owner:        OrganizationURN(5515715),
+		Reader:       bytes.NewReader(video),
+		Size:         int64(len(video)),
+		Title:        "Demo",
+		Captions:     strings.NewReader("1\n00:00:00,000 --> 00:00:01,000\nHello\n"),
+		Thumbnail:    bytes.NewReader(pngHeader),
+		Concurrency:  3,
+		PollInterval: time.Millisecond,
+		OnState: func(state VideoUploadState) {
+			mu.Lock()
+			states++
+			mu.Unlock()
+		},
+		OnProgress: func(uploaded, total int64) {
+			mu.Lock()
+			if uploaded > lastProgress {
+				lastProgress = uploaded
+			}
+			mu.Unlock()
+			if total != int64(len(video)) {
+				t.Errorf("OnProgress() total = %d; want %d", total, len(video))
+			}
+		},
+	})
+	if err != nil {
+		t.Fatalf("Upload() error = %v", err)
+	}
+	if v.URN != VideoURN("C5F10AQ") || v.Content().Media.Title != "Demo" {
+		t.Errorf("Upload() = %+v; want urn:li:video:C5F10AQ", v)
+	}
+
+	var uploaded []byte
+	for i := 0; i < len(f.parts); i++ {
+		uploaded = append(uploaded, f.parts[i]...)
+	}
+	if !bytes.Equal(uploaded, video) {
+		t.Errorf("uploaded %q; want %q", uploaded, video)
+	}
+	if want := []string{"etag-0", "etag-1", "etag-2", "etag-3", "etag-4", "etag-5", "etag-6"}; strings.Join(f.finalized, ",") != strings.Join(want, ",") {
+		t.Errorf("uploadedPartIds = %v; want %v", f.finalized, want)
+	}
+	if !bytes.HasPrefix(f.captions, []byte("1\n")) || !bytes.Equal(f.thumbnail, pngHeader) {
+		t.Errorf("captions = %q, thumbnail = %q", f.captions, f.thumbnail)
+	}
+	if lastProgress != int64(len(video)) || states == 0 {
+		t.Errorf("progress = %d, states = %d; want %d and > 0", lastProgress, states, len(video))
+	}
+}
+
+// TestVideosUploadResume tests resuming an upload after a failed part
+func TestVideosUploadResume(t *testing.T) {
+	f, session := newFakeVideoServer(t, 8)
+	f.failPart = 2
+	video := bytes.Repeat([]byte("x"), 30) // 4 parts
+
+	var saved VideoUploadState
+	req := UploadVideoRequest{
+		Owner:        PersonURN("abc"),
+		Reader:       bytes.NewReader(video),
+		Size:         int64(len(video)),
+		Concurrency:  1,
+		PollInterval: time.Millisecond,
+		OnState:      func(state VideoUploadState) { saved = state },
+	}
+	_, err := session.Videos().Upload(context.Background(), req)
+	if _, ok := AsAPIError(err); !ok {
+		t.Fatalf("Upload() error = %v; want *APIError", err)
+	}
+	if saved.Video == "" || saved.PartIDs[0] != "etag-0" || saved.PartIDs[2] != "" {
+		t.Fatalf("saved state = %+v; want first part uploaded", saved)
+	}
+
+	// persist the state as JSON and resume
+	data, _ := json.Marshal(saved)
+	var state VideoUploadState
+	_ = json.Unmarshal(data, &state)
+	f.parts = map[int][]byte{}
+	req.State = &state
+	if _, err := session.Videos().Upload(context.Background(), req); err != nil {
+		t.Fatalf("Upload() error = %v", err)
+	}
+
+	if f.initialized != 1 {
+		t.Errorf("initializeUpload called %d times; want 1", f.initialized)
+	}
+	if _, ok := f.parts[0]; ok {
+		t.Errorf("part 0 uploaded again")
+	}
+	if len(f.finalized) != 4 || f.finalized[2] != "etag-2" {
+		t.Errorf("uploadedPartIds = %v; want 4 ETags", f.finalized)
+	}
+}
+
+// TestVideosUploadProcessingFailed tests the PROCESSING_FAILED status
+func TestVideosUploadProcessingFailed(t *testing.T) {
+	f, session := newFakeVideoServer(t, 16)
+	f.finalStatus = MediaStatusProcessingFailed
+
+	_, err := session.Videos().Upload(context.Background(), UploadVideoRequest{
+		Owner:        PersonURN("abc"),
+		Reader:       strings.NewReader("video"),
+		Size:         5,
+		PollInterval: time.Millisecond,
+	})
+	if !errors.Is(err, ErrMediaProcessingFailed) {
+		t.Errorf("Upload() error = %v; want ErrMediaProcessingFailed", err)
+	}
+}